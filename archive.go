@@ -0,0 +1,234 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"time"
+)
+
+// ArchiveOptions holds optional settings for ArchiveZipWithOptions
+// and ArchiveTarWithOptions. The zero value selects the behaviour
+// of ArchiveZip and ArchiveTar.
+type ArchiveOptions struct {
+	// Reproducible causes every entry to be given the modification time
+	// ModTime instead of that of its file, so that the archive depends
+	// only on the names, modes and contents of the files.
+	// (Entries are always written in the lexical order of WalkDir.)
+	Reproducible bool
+
+	// ModTime is the modification time of all entries when Reproducible is set.
+	// If it is zero, 1980-01-01 00:00:00 UTC, the earliest time
+	// that zip archives can represent, is used.
+	ModTime time.Time
+
+	// Gzip causes ArchiveTarWithOptions to compress the archive with gzip.
+	// It is ignored for zip archives.
+	Gzip bool
+}
+
+// modTime returns the modification time to record for info.
+func (opts *ArchiveOptions) modTime(info FileInfo) time.Time {
+	if !opts.Reproducible {
+		return info.ModTime()
+	}
+	if opts.ModTime.IsZero() {
+		return time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return opts.ModTime.UTC()
+}
+
+// ArchiveZip writes the file tree rooted at root in fsys to w as a zip archive.
+// It is equivalent to ArchiveZipWithOptions with nil options.
+func ArchiveZip(w io.Writer, fsys FS, root string) error {
+	return ArchiveZipWithOptions(w, fsys, root, nil)
+}
+
+// ArchiveZipWithOptions writes the file tree rooted at root in fsys to w
+// as a zip archive, walking it with WalkDir.
+//
+// The entries are named relative to root, which itself has no entry;
+// if root is not a directory, the archive holds the single file root
+// under its base name. Directory names end in a slash. The FileMode of
+// each file, including its type bits, is recorded in its entry, and the
+// content of a symbolic link, which is not followed, is its destination
+// as reported by ReadLink.
+// A nil opts is equivalent to a pointer to a zero ArchiveOptions.
+func ArchiveZipWithOptions(w io.Writer, fsys FS, root string, opts *ArchiveOptions) error {
+	if opts == nil {
+		opts = &ArchiveOptions{}
+	}
+	zw := zip.NewWriter(w)
+	err := archiveWalk(fsys, root, func(name, rel string, info FileInfo) error {
+		mode := info.Mode()
+		fh := &zip.FileHeader{Name: rel, Method: zip.Deflate, Modified: opts.modTime(info)}
+		fh.SetMode(os.FileMode(mode))
+		if mode.IsDir() {
+			fh.Name += "/"
+			fh.Method = zip.Store
+		}
+		fw, err := zw.CreateHeader(fh)
+		if err != nil {
+			return err
+		}
+		switch {
+		case mode&ModeSymlink != 0:
+			target, err := ReadLink(fsys, name)
+			if err != nil {
+				return err
+			}
+			_, err = io.WriteString(fw, target)
+			return err
+		case mode.IsRegular():
+			return archiveCopy(fw, fsys, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// ArchiveTar writes the file tree rooted at root in fsys to w as a tar archive.
+// It is equivalent to ArchiveTarWithOptions with nil options.
+func ArchiveTar(w io.Writer, fsys FS, root string) error {
+	return ArchiveTarWithOptions(w, fsys, root, nil)
+}
+
+// ArchiveTarWithOptions writes the file tree rooted at root in fsys to w
+// as a tar archive, walking it with WalkDir, and compressing it with gzip
+// if opts.Gzip is set.
+//
+// Entries are named as described for ArchiveZipWithOptions. The type bits
+// of the FileMode of each file select the type of its entry: directories,
+// symbolic links (with the destination reported by ReadLink), named pipes
+// and character and block devices are supported, while sockets and
+// irregular files cause an error. The permission, setuid, setgid and
+// sticky bits are recorded in the mode of the entry. Owner and device
+// numbers are not recorded.
+// A nil opts is equivalent to a pointer to a zero ArchiveOptions.
+func ArchiveTarWithOptions(w io.Writer, fsys FS, root string, opts *ArchiveOptions) error {
+	if opts == nil {
+		opts = &ArchiveOptions{}
+	}
+	var zw *gzip.Writer
+	if opts.Gzip {
+		zw = gzip.NewWriter(w)
+		w = zw
+	}
+	tw := tar.NewWriter(w)
+	err := archiveWalk(fsys, root, func(name, rel string, info FileInfo) error {
+		mode := info.Mode()
+		hdr := &tar.Header{
+			Name:    rel,
+			Mode:    tarMode(mode),
+			ModTime: opts.modTime(info),
+		}
+		switch mode.Type() {
+		case 0:
+			hdr.Typeflag = tar.TypeReg
+			hdr.Size = info.Size()
+		case ModeDir:
+			hdr.Typeflag = tar.TypeDir
+			hdr.Name += "/"
+		case ModeSymlink:
+			hdr.Typeflag = tar.TypeSymlink
+			target, err := ReadLink(fsys, name)
+			if err != nil {
+				return err
+			}
+			hdr.Linkname = target
+		case ModeNamedPipe:
+			hdr.Typeflag = tar.TypeFifo
+		case ModeDevice | ModeCharDevice:
+			hdr.Typeflag = tar.TypeChar
+		case ModeDevice:
+			hdr.Typeflag = tar.TypeBlock
+		default:
+			return &PathError{Op: "archive", Path: name, Err: errors.New("unsupported file type " + mode.String())}
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if hdr.Typeflag == tar.TypeReg {
+			return archiveCopy(tw, fsys, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if zw != nil {
+		return zw.Close()
+	}
+	return nil
+}
+
+// tarMode returns the mode field of a tar header for mode.
+func tarMode(mode FileMode) int64 {
+	const (
+		cISUID = 04000 // Set uid
+		cISGID = 02000 // Set gid
+		cISVTX = 01000 // Save text (sticky bit)
+	)
+	m := int64(mode.Perm())
+	if mode&ModeSetuid != 0 {
+		m |= cISUID
+	}
+	if mode&ModeSetgid != 0 {
+		m |= cISGID
+	}
+	if mode&ModeSticky != 0 {
+		m |= cISVTX
+	}
+	return m
+}
+
+// archiveWalk walks the tree rooted at root, calling fn with the name
+// of each file, its name rel in the archive, and its FileInfo.
+func archiveWalk(fsys FS, root string, fn func(name, rel string, info FileInfo) error) error {
+	return WalkDir(fsys, root, func(name string, d DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		var rel string
+		switch {
+		case name == root && d.IsDir():
+			return nil
+		case name == root:
+			rel = path.Base(root)
+		case root == ".":
+			rel = name
+		default:
+			rel = name[len(root)+1:]
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return fn(name, rel, info)
+	})
+}
+
+// archiveCopy copies the content of the named file to w.
+func archiveCopy(w io.Writer, fsys FS, name string) error {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(w, file)
+	return err
+}
@@ -0,0 +1,212 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+// linkMapFS is a MapFS whose entries with ModeSymlink are symbolic links
+// to their data, as reported by ReadLink.
+type linkMapFS struct{ fstest.MapFS }
+
+func (m linkMapFS) ReadLink(name string) (string, error) {
+	f := m.MapFS[name]
+	if f == nil || f.Mode&fs.ModeSymlink == 0 {
+		return "", &fs.PathError{Op: "readlink", Path: name, Err: fs.ErrInvalid}
+	}
+	return string(f.Data), nil
+}
+
+// archiveFormats are the archive formats tested by the round-trip tests,
+// each with its writer and the file system reading it back.
+var archiveFormats = []struct {
+	name    string
+	archive func(*bytes.Buffer, fs.FS, string, *fs.ArchiveOptions) error
+	read    func(data []byte) (fs.FS, error)
+}{
+	{
+		"zip",
+		func(buf *bytes.Buffer, fsys fs.FS, root string, opts *fs.ArchiveOptions) error {
+			return fs.ArchiveZipWithOptions(buf, fsys, root, opts)
+		},
+		func(data []byte) (fs.FS, error) {
+			zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+			if err != nil {
+				return nil, err
+			}
+			return fs.ZipFS(zr), nil
+		},
+	},
+	{
+		"tar",
+		func(buf *bytes.Buffer, fsys fs.FS, root string, opts *fs.ArchiveOptions) error {
+			return fs.ArchiveTarWithOptions(buf, fsys, root, opts)
+		},
+		func(data []byte) (fs.FS, error) {
+			return fs.TarFS(bytes.NewReader(data), int64(len(data)))
+		},
+	},
+}
+
+// archiveTree returns the file tree archived by the round-trip tests,
+// with every file modified at mtime.
+func archiveTree(mtime time.Time) linkMapFS {
+	return linkMapFS{fstest.MapFS{
+		"a.txt":          {Data: []byte("a"), Mode: 0644, ModTime: mtime},
+		"bin":            {Mode: fs.ModeDir | 0755, ModTime: mtime},
+		"bin/run":        {Data: []byte("#!/bin/sh\n"), Mode: 0755, ModTime: mtime},
+		"dir":            {Mode: fs.ModeDir | 0750, ModTime: mtime},
+		"dir/empty":      {Mode: fs.ModeDir | 0755, ModTime: mtime},
+		"dir/empty.file": {Mode: 0644, ModTime: mtime},
+		"dir/secret":     {Data: []byte("secret"), Mode: 0600, ModTime: mtime},
+		"dir/up.link":    {Data: []byte("../a.txt"), Mode: fs.ModeSymlink | 0777, ModTime: mtime},
+		"dir.link":       {Data: []byte("dir"), Mode: fs.ModeSymlink | 0777, ModTime: mtime},
+	}}
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	mtime := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	tree := archiveTree(mtime)
+	for _, format := range archiveFormats {
+		var buf bytes.Buffer
+		if err := format.archive(&buf, tree, ".", nil); err != nil {
+			t.Fatalf("%s: %v", format.name, err)
+		}
+		fsys, err := format.read(buf.Bytes())
+		if err != nil {
+			t.Fatalf("%s: %v", format.name, err)
+		}
+		if err := fstest.TestFS(fsys, "a.txt", "bin/run", "dir/secret", "dir/up.link", "dir/empty",
+			"dir/empty.file", "dir.link"); err != nil {
+			t.Fatalf("%s: %v", format.name, err)
+		}
+		if data, err := fs.ReadFile(fsys, "dir.link/secret"); string(data) != "secret" || err != nil {
+			t.Errorf("%s: ReadFile(dir.link/secret) = %q, %v, want %q, nil", format.name, data, err, "secret")
+		}
+
+		for name, f := range tree.MapFS {
+			info, err := fs.Lstat(fsys, name)
+			if err != nil {
+				t.Errorf("%s: %v", format.name, err)
+				continue
+			}
+			if info.Mode() != f.Mode || !info.ModTime().Equal(mtime) {
+				t.Errorf("%s: Lstat(%s) = %v %v, want %v %v", format.name, name, info.Mode(), info.ModTime(), f.Mode, mtime)
+			}
+			switch {
+			case f.Mode&fs.ModeSymlink != 0:
+				if target, err := fs.ReadLink(fsys, name); target != string(f.Data) || err != nil {
+					t.Errorf("%s: ReadLink(%s) = %q, %v, want %q, nil", format.name, name, target, err, f.Data)
+				}
+			case f.Mode.IsRegular():
+				if data, err := fs.ReadFile(fsys, name); string(data) != string(f.Data) || err != nil {
+					t.Errorf("%s: ReadFile(%s) = %q, %v, want %q, nil", format.name, name, data, err, f.Data)
+				}
+			}
+		}
+	}
+}
+
+func TestArchiveRoot(t *testing.T) {
+	tree := archiveTree(time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC))
+	for _, format := range archiveFormats {
+		// A directory root is stripped from the names of the entries.
+		var buf bytes.Buffer
+		if err := format.archive(&buf, tree, "dir", nil); err != nil {
+			t.Fatalf("%s: %v", format.name, err)
+		}
+		fsys, err := format.read(buf.Bytes())
+		if err != nil {
+			t.Fatalf("%s: %v", format.name, err)
+		}
+		if err := fstest.TestFS(fsys, "secret", "up.link", "empty", "empty.file"); err != nil {
+			t.Fatalf("%s: %v", format.name, err)
+		}
+		if _, err := fs.Stat(fsys, "dir"); err == nil {
+			t.Errorf("%s: Stat(dir) succeeded, want error", format.name)
+		}
+
+		// A file root is archived under its base name.
+		buf.Reset()
+		if err := format.archive(&buf, tree, "dir/secret", nil); err != nil {
+			t.Fatalf("%s: %v", format.name, err)
+		}
+		fsys, err = format.read(buf.Bytes())
+		if err != nil {
+			t.Fatalf("%s: %v", format.name, err)
+		}
+		if data, err := fs.ReadFile(fsys, "secret"); string(data) != "secret" || err != nil {
+			t.Errorf("%s: ReadFile(secret) = %q, %v, want %q, nil", format.name, data, err, "secret")
+		}
+	}
+}
+
+func TestArchiveReproducible(t *testing.T) {
+	modTime := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, format := range archiveFormats {
+		for _, opts := range []*fs.ArchiveOptions{
+			{Reproducible: true},
+			{Reproducible: true, ModTime: modTime},
+			{Reproducible: true, Gzip: true},
+		} {
+			want := opts.ModTime
+			if want.IsZero() {
+				want = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+			}
+
+			// Archives of trees differing only in modification times are identical.
+			var a, b bytes.Buffer
+			if err := format.archive(&a, archiveTree(time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)), ".", opts); err != nil {
+				t.Fatalf("%s: %v", format.name, err)
+			}
+			if err := format.archive(&b, archiveTree(time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)), ".", opts); err != nil {
+				t.Fatalf("%s: %v", format.name, err)
+			}
+			if !bytes.Equal(a.Bytes(), b.Bytes()) {
+				t.Errorf("%s: %+v: archives of the same tree differ", format.name, *opts)
+			}
+
+			fsys, err := format.read(a.Bytes())
+			if err != nil {
+				t.Fatalf("%s: %v", format.name, err)
+			}
+			for _, name := range []string{"a.txt", "dir", "dir/up.link"} {
+				info, err := fs.Lstat(fsys, name)
+				if err != nil {
+					t.Fatalf("%s: %v", format.name, err)
+				}
+				if !info.ModTime().Equal(want) {
+					t.Errorf("%s: %+v: Lstat(%s).ModTime() = %v, want %v", format.name, *opts, name, info.ModTime(), want)
+				}
+			}
+		}
+	}
+}
+
+func TestArchiveTarGzip(t *testing.T) {
+	tree := archiveTree(time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC))
+	var buf bytes.Buffer
+	if err := fs.ArchiveTarWithOptions(&buf, tree, ".", &fs.ArchiveOptions{Gzip: true}); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	if len(data) < 2 || data[0] != 0x1f || data[1] != 0x8b {
+		t.Fatalf("archive does not start with the gzip magic number")
+	}
+	fsys, err := fs.TarFS(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fstest.TestFS(fsys, "a.txt", "bin/run", "dir/secret", "dir/up.link", "dir.link"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"bytes"
+	"container/list"
+	"errors"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// defaultCacheBytes is the size of a CacheFS if CacheOptions.MaxBytes <= 0.
+const defaultCacheBytes = 32 << 20
+
+// CacheOptions holds optional settings for NewCacheFS.
+type CacheOptions struct {
+	// MaxBytes bounds the approximate memory used by cached results.
+	// When it is exceeded, the least recently used results are evicted.
+	// If MaxBytes <= 0, a default of 32 MiB is used.
+	MaxBytes int64
+
+	// TTL, if positive, is how long a result remains cached.
+	// Otherwise results remain cached until evicted or invalidated.
+	TTL time.Duration
+}
+
+// CacheStats holds the counters of a CacheFS.
+type CacheStats struct {
+	Hits      int64 // results served from the cache
+	Misses    int64 // results not found in the cache, including those shared by concurrent requests and files Open could not serve
+	Evictions int64 // results evicted to respect MaxBytes
+}
+
+// A CacheFS is a file system that caches the results of Stat, ReadDir
+// and ReadFile on another file system, in memory.
+//
+// Only successful results are cached. Concurrent requests for a result
+// that is not cached are combined into a single request to the underlying
+// file system. Open returns files served from memory when both the
+// content and the FileInfo of a regular file are cached, and otherwise
+// opens the file in the underlying file system, without caching.
+// Changes to the underlying file system are not noticed until the results
+// expire, are evicted, or are dropped by Invalidate.
+//
+// A CacheFS implements StatFS, ReadDirFS and ReadFileFS,
+// and is safe for concurrent use.
+type CacheFS struct {
+	hits, misses, evictions int64 // accessed atomically
+
+	fsys FS
+	opts CacheOptions
+
+	mu      sync.Mutex
+	lru     *list.List // of *cacheEntry, most recently used first
+	entries map[cacheKey]*list.Element
+	calls   map[cacheKey]*cacheCall // requests in progress
+	size    int64                   // total size of entries
+	gen     uint64                  // incremented by Invalidate
+}
+
+// NewCacheFS returns a CacheFS caching results from fsys.
+// A nil opts is equivalent to a pointer to a zero CacheOptions.
+func NewCacheFS(fsys FS, opts *CacheOptions) *CacheFS {
+	c := &CacheFS{
+		fsys:    fsys,
+		lru:     list.New(),
+		entries: make(map[cacheKey]*list.Element),
+		calls:   make(map[cacheKey]*cacheCall),
+	}
+	if opts != nil {
+		c.opts = *opts
+	}
+	if c.opts.MaxBytes <= 0 {
+		c.opts.MaxBytes = defaultCacheBytes
+	}
+	return c
+}
+
+type cacheOp int
+
+const (
+	cacheStat cacheOp = iota
+	cacheReadDir
+	cacheReadFile
+)
+
+// cacheOpNames holds the Op of the errors reported for each cacheOp.
+var cacheOpNames = [...]string{
+	cacheStat:     "stat",
+	cacheReadDir:  "readdir",
+	cacheReadFile: "read",
+}
+
+type cacheKey struct {
+	op   cacheOp
+	name string
+}
+
+type cacheEntry struct {
+	key     cacheKey
+	value   interface{}
+	size    int64
+	expires time.Time // zero if the entry does not expire
+}
+
+// A cacheCall is a request to the underlying file system in progress.
+type cacheCall struct {
+	wg    sync.WaitGroup
+	value interface{}
+	err   error
+}
+
+// Approximate sizes of cached FileInfo and DirEntry values,
+// in addition to the length of their names.
+const (
+	cacheInfoSize  = 128
+	cacheEntrySize = 64
+)
+
+// Stats returns the current values of the counters of c.
+func (c *CacheFS) Stats() CacheStats {
+	return CacheStats{
+		Hits:      atomic.LoadInt64(&c.hits),
+		Misses:    atomic.LoadInt64(&c.misses),
+		Evictions: atomic.LoadInt64(&c.evictions),
+	}
+}
+
+// Invalidate drops the cached results for name and for all files below it,
+// as well as the cached listing of the directory containing name.
+// Requests to the underlying file system in progress for those names
+// do not add their results to the cache.
+func (c *CacheFS) Invalidate(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	under := func(key cacheKey) bool {
+		return name == "." || key.name == name || strings.HasPrefix(key.name, name+"/") ||
+			key.op == cacheReadDir && key.name == path.Dir(name)
+	}
+	for key, e := range c.entries {
+		if under(key) {
+			c.remove(e)
+		}
+	}
+	for key := range c.calls {
+		if under(key) {
+			delete(c.calls, key)
+		}
+	}
+}
+
+// lookup returns the cached value for key, if any.
+// The caller must hold c.mu.
+func (c *CacheFS) lookup(key cacheKey) (interface{}, bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	ent := e.Value.(*cacheEntry)
+	if !ent.expires.IsZero() && !time.Now().Before(ent.expires) {
+		c.remove(e)
+		return nil, false
+	}
+	c.lru.MoveToFront(e)
+	return ent.value, true
+}
+
+// get returns the value for key from the cache, or otherwise from load,
+// which returns the value and its size. Concurrent calls for the same key
+// share a single call of load. If load panics, the calls waiting for it
+// return an error, and the next call for key calls load again.
+func (c *CacheFS) get(key cacheKey, load func() (interface{}, int64, error)) (interface{}, error) {
+	c.mu.Lock()
+	if value, ok := c.lookup(key); ok {
+		c.mu.Unlock()
+		atomic.AddInt64(&c.hits, 1)
+		return value, nil
+	}
+	atomic.AddInt64(&c.misses, 1)
+	if call, ok := c.calls[key]; ok {
+		c.mu.Unlock()
+		call.wg.Wait()
+		return call.value, call.err
+	}
+	call := new(cacheCall)
+	call.wg.Add(1)
+	c.calls[key] = call
+	gen := c.gen
+	c.mu.Unlock()
+
+	// The error is replaced by the result of load unless it panics.
+	call.err = &PathError{Op: cacheOpNames[key.op], Path: key.name, Err: errors.New("panic in request to the underlying file system")}
+	var size int64
+	defer func() {
+		c.mu.Lock()
+		if c.calls[key] == call {
+			delete(c.calls, key)
+		}
+		if call.err == nil && gen == c.gen {
+			c.add(key, call.value, size)
+		}
+		c.mu.Unlock()
+		call.wg.Done()
+	}()
+	call.value, size, call.err = load()
+	return call.value, call.err
+}
+
+// add adds the value for key to the cache, evicting other entries as needed.
+// The caller must hold c.mu.
+func (c *CacheFS) add(key cacheKey, value interface{}, size int64) {
+	if size > c.opts.MaxBytes {
+		return
+	}
+	if e, ok := c.entries[key]; ok {
+		c.remove(e)
+	}
+	ent := &cacheEntry{key: key, value: value, size: size}
+	if c.opts.TTL > 0 {
+		ent.expires = time.Now().Add(c.opts.TTL)
+	}
+	c.entries[key] = c.lru.PushFront(ent)
+	c.size += size
+	for c.size > c.opts.MaxBytes {
+		c.remove(c.lru.Back())
+		atomic.AddInt64(&c.evictions, 1)
+	}
+}
+
+// remove removes the entry e from the cache.
+// The caller must hold c.mu.
+func (c *CacheFS) remove(e *list.Element) {
+	ent := c.lru.Remove(e).(*cacheEntry)
+	delete(c.entries, ent.key)
+	c.size -= ent.size
+}
+
+func (c *CacheFS) Open(name string) (File, error) {
+	c.mu.Lock()
+	data, ok := c.lookup(cacheKey{cacheReadFile, name})
+	var info interface{}
+	if ok {
+		info, ok = c.lookup(cacheKey{cacheStat, name})
+	}
+	c.mu.Unlock()
+	if ok && info.(FileInfo).Mode().IsRegular() {
+		atomic.AddInt64(&c.hits, 1)
+		return &cacheFile{bytes.NewReader(data.([]byte)), info.(FileInfo)}, nil
+	}
+	atomic.AddInt64(&c.misses, 1)
+	return c.fsys.Open(name)
+}
+
+func (c *CacheFS) Stat(name string) (FileInfo, error) {
+	info, err := c.get(cacheKey{cacheStat, name}, func() (interface{}, int64, error) {
+		info, err := Stat(c.fsys, name)
+		return info, cacheInfoSize + int64(len(name)), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return info.(FileInfo), nil
+}
+
+func (c *CacheFS) ReadDir(name string) ([]DirEntry, error) {
+	list, err := c.get(cacheKey{cacheReadDir, name}, func() (interface{}, int64, error) {
+		list, err := ReadDir(c.fsys, name)
+		size := cacheInfoSize + int64(len(name))
+		for _, d := range list {
+			size += cacheEntrySize + int64(len(d.Name()))
+		}
+		return list, size, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]DirEntry(nil), list.([]DirEntry)...), nil
+}
+
+func (c *CacheFS) ReadFile(name string) ([]byte, error) {
+	data, err := c.get(cacheKey{cacheReadFile, name}, func() (interface{}, int64, error) {
+		data, err := ReadFile(c.fsys, name)
+		return data, int64(len(data)) + int64(len(name)), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(nil), data.([]byte)...), nil
+}
+
+// A cacheFile is a regular file served from the content cached by a CacheFS.
+type cacheFile struct {
+	*bytes.Reader
+	info FileInfo
+}
+
+func (f *cacheFile) Stat() (FileInfo, error) { return f.info, nil }
+func (f *cacheFile) Close() error            { return nil }
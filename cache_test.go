@@ -0,0 +1,255 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+// A countFS counts the calls of ReadFile for each name. If block is not nil,
+// ReadFile waits for it to be closed, and if panics is set, the first call
+// for each name panics.
+type countFS struct {
+	fstest.MapFS
+	block  chan struct{}
+	panics bool
+
+	mu    sync.Mutex
+	reads map[string]int
+}
+
+func newCountFS(m fstest.MapFS) *countFS {
+	return &countFS{MapFS: m, reads: make(map[string]int)}
+}
+
+func (f *countFS) ReadFile(name string) ([]byte, error) {
+	f.mu.Lock()
+	f.reads[name]++
+	n := f.reads[name]
+	f.mu.Unlock()
+	if f.block != nil {
+		<-f.block
+	}
+	if f.panics && n == 1 {
+		panic("countFS: ReadFile " + name)
+	}
+	return f.MapFS.ReadFile(name)
+}
+
+func (f *countFS) count(name string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.reads[name]
+}
+
+// waitMisses waits until c has counted n misses.
+func waitMisses(t *testing.T, c *fs.CacheFS, n int64) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for c.Stats().Misses < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("Misses = %d, want %d", c.Stats().Misses, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestCacheFS(t *testing.T) {
+	c := fs.NewCacheFS(fstest.MapFS{
+		"a.txt":     {Data: []byte("a")},
+		"dir/b.txt": {Data: []byte("b")},
+		"dir/c":     {Mode: fs.ModeDir},
+	}, nil)
+	// The second run is served from the cache.
+	for i := 0; i < 2; i++ {
+		if err := fstest.TestFS(c, "a.txt", "dir/b.txt", "dir/c"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if s := c.Stats(); s.Hits == 0 || s.Misses == 0 || s.Evictions != 0 {
+		t.Errorf("Stats() = %+v, want hits and misses but no evictions", s)
+	}
+}
+
+func TestCacheFSOpen(t *testing.T) {
+	c := fs.NewCacheFS(fstest.MapFS{"a": {Data: []byte("data")}}, nil)
+	open := func() {
+		t.Helper()
+		f, err := c.Open("a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Close()
+	}
+
+	// Open falls through to the underlying file system
+	// until both the content and the FileInfo are cached.
+	open()
+	if s := c.Stats(); s != (fs.CacheStats{Misses: 1}) {
+		t.Errorf("Stats() after Open = %+v, want {Misses:1}", s)
+	}
+	if _, err := c.ReadFile("a"); err != nil {
+		t.Fatal(err)
+	}
+	open()
+	if s := c.Stats(); s != (fs.CacheStats{Misses: 3}) {
+		t.Errorf("Stats() after ReadFile and Open = %+v, want {Misses:3}", s)
+	}
+	if _, err := c.Stat("a"); err != nil {
+		t.Fatal(err)
+	}
+	open()
+	if s := c.Stats(); s != (fs.CacheStats{Hits: 1, Misses: 4}) {
+		t.Errorf("Stats() after Stat and Open = %+v, want {Hits:1 Misses:4}", s)
+	}
+}
+
+func TestCacheFSShared(t *testing.T) {
+	fsys := newCountFS(fstest.MapFS{"a": {Data: []byte("data")}})
+	fsys.block = make(chan struct{})
+	c := fs.NewCacheFS(fsys, nil)
+
+	const n = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data, err := c.ReadFile("a")
+			if err == nil && string(data) != "data" {
+				t.Errorf("ReadFile(a) = %q, want %q", data, "data")
+			}
+			errs <- err
+		}()
+	}
+	waitMisses(t, c, n)
+	close(fsys.block)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("ReadFile(a): %v", err)
+		}
+	}
+	if got := fsys.count("a"); got != 1 {
+		t.Errorf("%d concurrent ReadFile calls read the file %d times, want 1", n, got)
+	}
+	if _, err := c.ReadFile("a"); err != nil || fsys.count("a") != 1 {
+		t.Errorf("ReadFile(a) after load = %v, read %d times, want nil, 1", err, fsys.count("a"))
+	}
+}
+
+func TestCacheFSTTL(t *testing.T) {
+	fsys := newCountFS(fstest.MapFS{"a": {Data: []byte("data")}})
+	c := fs.NewCacheFS(fsys, &fs.CacheOptions{TTL: 20 * time.Millisecond})
+	for i := 0; i < 2; i++ {
+		if _, err := c.ReadFile("a"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := fsys.count("a"); got != 1 {
+		t.Fatalf("read %d times before expiry, want 1", got)
+	}
+	time.Sleep(40 * time.Millisecond)
+	if _, err := c.ReadFile("a"); err != nil {
+		t.Fatal(err)
+	}
+	if got := fsys.count("a"); got != 2 {
+		t.Errorf("read %d times after expiry, want 2", got)
+	}
+}
+
+func TestCacheFSEvict(t *testing.T) {
+	data := bytes.Repeat([]byte("x"), 100)
+	fsys := newCountFS(fstest.MapFS{
+		"a": {Data: data},
+		"b": {Data: data},
+		"c": {Data: data},
+	})
+	c := fs.NewCacheFS(fsys, &fs.CacheOptions{MaxBytes: 250})
+	for _, name := range []string{"a", "b", "a", "c"} {
+		if _, err := c.ReadFile(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// a was used more recently than b, so b was evicted for c.
+	if s := c.Stats(); s != (fs.CacheStats{Hits: 1, Misses: 3, Evictions: 1}) {
+		t.Errorf("Stats() = %+v, want {Hits:1 Misses:3 Evictions:1}", s)
+	}
+	for _, name := range []string{"a", "b", "c"} {
+		if _, err := c.ReadFile(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if a, b := fsys.count("a"), fsys.count("b"); a != 1 || b != 2 {
+		t.Errorf("read a %d and b %d times, want 1 and 2", a, b)
+	}
+}
+
+func TestCacheFSInvalidate(t *testing.T) {
+	m := fstest.MapFS{"dir/a": {Data: []byte("old")}}
+	c := fs.NewCacheFS(m, nil)
+	if _, err := c.ReadFile("dir/a"); err != nil {
+		t.Fatal(err)
+	}
+	if list, err := c.ReadDir("dir"); len(list) != 1 || err != nil {
+		t.Fatalf("ReadDir(dir) = %v, %v, want 1 entry", list, err)
+	}
+	m["dir/a"] = &fstest.MapFile{Data: []byte("new")}
+	m["dir/b"] = &fstest.MapFile{Data: []byte("b")}
+	if data, _ := c.ReadFile("dir/a"); string(data) != "old" {
+		t.Fatalf("ReadFile(dir/a) before Invalidate = %q, want %q", data, "old")
+	}
+	c.Invalidate("dir/a")
+	if data, err := c.ReadFile("dir/a"); string(data) != "new" || err != nil {
+		t.Errorf("ReadFile(dir/a) = %q, %v, want %q, nil", data, err, "new")
+	}
+	if list, err := c.ReadDir("dir"); len(list) != 2 || err != nil {
+		t.Errorf("ReadDir(dir) = %v, %v, want 2 entries", list, err)
+	}
+}
+
+func TestCacheFSPanic(t *testing.T) {
+	fsys := newCountFS(fstest.MapFS{"a": {Data: []byte("data")}})
+	fsys.block = make(chan struct{})
+	fsys.panics = true
+	c := fs.NewCacheFS(fsys, nil)
+
+	panicked := make(chan interface{})
+	go func() {
+		defer func() { panicked <- recover() }()
+		c.ReadFile("a")
+	}()
+	waitMisses(t, c, 1)
+	waited := make(chan error)
+	go func() {
+		_, err := c.ReadFile("a")
+		waited <- err
+	}()
+	waitMisses(t, c, 2)
+	close(fsys.block)
+
+	if p := <-panicked; p == nil {
+		t.Error("ReadFile(a) did not panic")
+	}
+	select {
+	case err := <-waited:
+		if err == nil {
+			t.Error("concurrent ReadFile(a) = nil error, want error")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("concurrent ReadFile(a) still waiting after panic")
+	}
+	if data, err := c.ReadFile("a"); string(data) != "data" || err != nil {
+		t.Errorf("ReadFile(a) after panic = %q, %v, want %q, nil", data, err, "data")
+	}
+}
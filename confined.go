@@ -0,0 +1,125 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import "errors"
+
+// ConfinedSub returns an FS corresponding to the subtree rooted at fsys's dir,
+// like Sub, but confines symbolic links to that subtree.
+//
+// Before each operation, every element of the name is resolved
+// using Lstat and ReadLink on fsys. Symbolic links leading to files
+// within the subtree are followed as usual, while a link whose destination
+// is absolute, or which leads outside the subtree, causes the operation to
+// fail with a *PathError wrapping ErrPermission. The final element of the
+// name is not resolved by Lstat and ReadLink, which act on the link itself.
+// Entries for escaping links are still listed by ReadDir.
+//
+// The elements of dir are resolved in the same way, within fsys, when
+// ConfinedSub is called: if dir does not exist or a symbolic link in it leads
+// outside fsys, ConfinedSub returns a *PathError, wrapping ErrPermission
+// in the latter case.
+//
+// Because the links are resolved before the operation is passed on to fsys,
+// ConfinedSub does not protect against links that are changed concurrently
+// by other writers. If fsys does not implement LstatFS, symbolic links
+// cannot be detected, and fsys is assumed not to contain any.
+//
+// The returned file system implements StatFS, ReadDirFS and ReadFileFS,
+// and LstatFS and ReadLinkFS if fsys implements them.
+func ConfinedSub(fsys FS, dir string) (FS, error) {
+	if !ValidPath(dir) {
+		return nil, &PathError{Op: "sub", Path: dir, Err: errors.New("invalid name")}
+	}
+	full, err := resolveLinks(fsys, "sub", dir, true, ErrPermission, nil)
+	if err != nil {
+		if e, ok := err.(*PathError); ok {
+			e.Op = "sub"
+			e.Path = dir
+		}
+		return nil, err
+	}
+	sub, err := Sub(fsys, full)
+	if err != nil {
+		return nil, err
+	}
+	return wrapFS(&confinedFS{sub}, sub, wrapAll), nil
+}
+
+type confinedFS struct {
+	fsys FS // the subtree
+}
+
+// resolve returns name with its symbolic links resolved within the subtree,
+// except for a link in its final element unless followLast is set.
+func (c *confinedFS) resolve(op, name string, followLast bool) (string, error) {
+	full, err := resolveLinks(c.fsys, op, name, followLast, ErrPermission, nil)
+	return full, c.fixErr(err, op, name)
+}
+
+// fixErr reports a *PathError returned by the subtree under
+// the operation op and the name passed to the confinedFS, rather than
+// the name with its symbolic links resolved.
+func (c *confinedFS) fixErr(err error, op, name string) error {
+	if e, ok := err.(*PathError); ok {
+		e.Op = op
+		e.Path = name
+	}
+	return err
+}
+
+func (c *confinedFS) Open(name string) (File, error) {
+	full, err := c.resolve("open", name, true)
+	if err != nil {
+		return nil, err
+	}
+	file, err := c.fsys.Open(full)
+	return file, c.fixErr(err, "open", name)
+}
+
+func (c *confinedFS) Stat(name string) (FileInfo, error) {
+	full, err := c.resolve("stat", name, true)
+	if err != nil {
+		return nil, err
+	}
+	info, err := Stat(c.fsys, full)
+	return info, c.fixErr(err, "stat", name)
+}
+
+func (c *confinedFS) Lstat(name string) (FileInfo, error) {
+	full, err := c.resolve("lstat", name, false)
+	if err != nil {
+		return nil, err
+	}
+	info, err := Lstat(c.fsys, full)
+	return info, c.fixErr(err, "lstat", name)
+}
+
+func (c *confinedFS) ReadLink(name string) (string, error) {
+	full, err := c.resolve("readlink", name, false)
+	if err != nil {
+		return "", err
+	}
+	target, err := ReadLink(c.fsys, full)
+	return target, c.fixErr(err, "readlink", name)
+}
+
+func (c *confinedFS) ReadDir(name string) ([]DirEntry, error) {
+	full, err := c.resolve("readdir", name, true)
+	if err != nil {
+		return nil, err
+	}
+	list, err := ReadDir(c.fsys, full)
+	return list, c.fixErr(err, "readdir", name)
+}
+
+func (c *confinedFS) ReadFile(name string) ([]byte, error) {
+	full, err := c.resolve("read", name, true)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ReadFile(c.fsys, full)
+	return data, c.fixErr(err, "read", name)
+}
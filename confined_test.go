@@ -0,0 +1,109 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+// makeLinks creates the symbolic links in dir named by the keys of links,
+// pointing to their values, or skips the test if that is not possible.
+func makeLinks(t *testing.T, dir string, links map[string]string) {
+	t.Helper()
+	for link, target := range links {
+		if err := os.Symlink(target, filepath.Join(dir, filepath.FromSlash(link))); err != nil {
+			t.Skipf("cannot create symlinks: %v", err)
+		}
+	}
+}
+
+func TestConfinedSub(t *testing.T) {
+	dir := t.TempDir()
+	makeTree(t, dir, "secret.txt", "root/a.txt", "root/sub/b.txt")
+	makeLinks(t, dir, map[string]string{
+		"root/sub/up.link": "../a.txt",
+		"root/dir.link":    "sub",
+	})
+	fsys, err := fs.ConfinedSub(fs.DirFS(dir), "root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fstest.TestFS(fsys, "a.txt", "sub/b.txt", "sub/up.link", "dir.link"); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := fs.ReadFile(fsys, "dir.link/up.link"); string(data) != "root/a.txt" || err != nil {
+		t.Errorf("ReadFile(dir.link/up.link) = %q, %v, want %q, nil", data, err, "root/a.txt")
+	}
+}
+
+func TestConfinedSubEscape(t *testing.T) {
+	dir := t.TempDir()
+	makeTree(t, dir, "secret.txt", "root/a.txt")
+	makeLinks(t, dir, map[string]string{
+		"root/escape.link": "../secret.txt",
+		"root/abs.link":    filepath.Join(dir, "secret.txt"),
+		"root/outdir.link": "..",
+	})
+	fsys, err := fs.ConfinedSub(fs.DirFS(dir), "root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"escape.link", "abs.link", "outdir.link/secret.txt"} {
+		if _, err := fs.ReadFile(fsys, name); !errors.Is(err, fs.ErrPermission) {
+			t.Errorf("ReadFile(%s) = %v, want ErrPermission", name, err)
+		}
+		if _, err := fsys.Open(name); !errors.Is(err, fs.ErrPermission) {
+			t.Errorf("Open(%s) = %v, want ErrPermission", name, err)
+		}
+	}
+	if _, err := fs.Lstat(fsys, "escape.link"); err != nil {
+		t.Errorf("Lstat(escape.link) = %v, want nil", err)
+	}
+	if target, err := fs.ReadLink(fsys, "escape.link"); target != "../secret.txt" || err != nil {
+		t.Errorf("ReadLink(escape.link) = %q, %v, want %q, nil", target, err, "../secret.txt")
+	}
+	list, err := fs.ReadDir(fsys, ".")
+	if err != nil || len(list) != 4 {
+		t.Errorf("ReadDir(.) = %d entries, %v, want 4 entries", len(list), err)
+	}
+}
+
+func TestConfinedSubEscapeDir(t *testing.T) {
+	dir := t.TempDir()
+	makeTree(t, dir, "outside/secret", "root/inside/a.txt")
+	makeLinks(t, dir, map[string]string{
+		"root/up":      "../outside",
+		"root/abs":     filepath.Join(dir, "outside"),
+		"root/in.link": "inside",
+	})
+	fsys := fs.DirFS(filepath.Join(dir, "root"))
+	for _, name := range []string{"up", "abs", "up/secret"} {
+		sub, err := fs.ConfinedSub(fsys, name)
+		if !errors.Is(err, fs.ErrPermission) {
+			t.Errorf("ConfinedSub(%s) = %v, want ErrPermission", name, err)
+			if sub != nil {
+				if data, err := fs.ReadFile(sub, "secret"); err == nil {
+					t.Errorf("ReadFile(secret) in ConfinedSub(%s) = %q, want error", name, data)
+				}
+			}
+		}
+	}
+	if _, err := fs.ConfinedSub(fsys, "missing"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ConfinedSub(missing) = %v, want ErrNotExist", err)
+	}
+	sub, err := fs.ConfinedSub(fsys, "in.link")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, err := fs.ReadFile(sub, "a.txt"); string(data) != "root/inside/a.txt" || err != nil {
+		t.Errorf("ReadFile(a.txt) = %q, %v, want %q, nil", data, err, "root/inside/a.txt")
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"time"
+)
+
+// DirFS returns a file system (an FS) for the tree of files rooted at the directory dir.
+//
+// Note that DirFS("/prefix") only guarantees that the Open calls it makes to the
+// operating system will begin with "/prefix": DirFS("/prefix").Open("file") is the
+// same as os.Open("/prefix/file"). So if /prefix/file is a symbolic link pointing outside
+// the /prefix tree, then using DirFS does not stop the access any more than using
+// os.Open does. DirFS is therefore not a general substitute for a chroot-style security
+// mechanism when the directory tree contains arbitrary content.
+//
+// The returned file system implements StatFS, LstatFS, ReadLinkFS, ReadDirFS and ReadFileFS,
+// as well as CreateFS, WriteFileFS, MkdirFS, MkdirAllFS, RemoveFS,
+// RemoveAllFS and RenameFS.
+// Files returned by its Open method implement ReadDirFile, io.ReaderAt and io.Seeker.
+func DirFS(dir string) FS {
+	return dirFS(dir)
+}
+
+type dirFS string
+
+// join maps name to the host path of the named file.
+func (dir dirFS) join(op, name string) (string, error) {
+	if !ValidPath(name) || runtime.GOOS == "windows" && containsAny(name, `\:`) {
+		return "", &PathError{Op: op, Path: name, Err: ErrInvalid}
+	}
+	if name == "." {
+		return string(dir), nil
+	}
+	return string(dir) + "/" + name, nil
+}
+
+func (dir dirFS) Open(name string) (File, error) {
+	full, err := dir.join("open", name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(full)
+	if err != nil {
+		return nil, fixOSErr(err, name)
+	}
+	return &dirFile{f, name}, nil
+}
+
+func (dir dirFS) Stat(name string) (FileInfo, error) {
+	full, err := dir.join("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(full)
+	if err != nil {
+		return nil, fixOSErr(err, name)
+	}
+	return fileInfoFromOS(info), nil
+}
+
+func (dir dirFS) Lstat(name string) (FileInfo, error) {
+	full, err := dir.join("lstat", name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Lstat(full)
+	if err != nil {
+		return nil, fixOSErr(err, name)
+	}
+	return fileInfoFromOS(info), nil
+}
+
+func (dir dirFS) ReadLink(name string) (string, error) {
+	full, err := dir.join("readlink", name)
+	if err != nil {
+		return "", err
+	}
+	target, err := os.Readlink(full)
+	if err != nil {
+		return "", fixOSErr(err, name)
+	}
+	return target, nil
+}
+
+func (dir dirFS) ReadDir(name string) ([]DirEntry, error) {
+	full, err := dir.join("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	infos, err := ioutil.ReadDir(full)
+	if err != nil {
+		return nil, fixOSErr(err, name)
+	}
+	list := make([]DirEntry, len(infos))
+	for i, info := range infos {
+		list[i] = &statDirEntry{fileInfoFromOS(info)}
+	}
+	return list, nil
+}
+
+func (dir dirFS) ReadFile(name string) ([]byte, error) {
+	full, err := dir.join("read", name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(full)
+	if err != nil {
+		return nil, fixOSErr(err, name)
+	}
+	return data, nil
+}
+
+func (dir dirFS) Create(name string, perm FileMode) (WritableFile, error) {
+	full, err := dir.join("open", name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(full, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, os.FileMode(perm))
+	if err != nil {
+		return nil, fixOSErr(err, name)
+	}
+	return &dirWriteFile{dirFile{f, name}}, nil
+}
+
+func (dir dirFS) WriteFile(name string, data []byte, perm FileMode) error {
+	full, err := dir.join("write", name)
+	if err != nil {
+		return err
+	}
+	return fixOSErr(ioutil.WriteFile(full, data, os.FileMode(perm)), name)
+}
+
+func (dir dirFS) Mkdir(name string, perm FileMode) error {
+	full, err := dir.join("mkdir", name)
+	if err != nil {
+		return err
+	}
+	return fixOSErr(os.Mkdir(full, os.FileMode(perm)), name)
+}
+
+func (dir dirFS) MkdirAll(name string, perm FileMode) error {
+	full, err := dir.join("mkdir", name)
+	if err != nil {
+		return err
+	}
+	return fixOSErr(os.MkdirAll(full, os.FileMode(perm)), name)
+}
+
+func (dir dirFS) Remove(name string) error {
+	full, err := dir.join("remove", name)
+	if err != nil {
+		return err
+	}
+	return fixOSErr(os.Remove(full), name)
+}
+
+func (dir dirFS) RemoveAll(name string) error {
+	if name == "." {
+		return &PathError{Op: "RemoveAll", Path: name, Err: ErrInvalid}
+	}
+	full, err := dir.join("RemoveAll", name)
+	if err != nil {
+		return err
+	}
+	return fixOSErr(os.RemoveAll(full), name)
+}
+
+func (dir dirFS) Rename(oldname, newname string) error {
+	oldfull, err := dir.join("rename", oldname)
+	if err != nil {
+		return err
+	}
+	newfull, err := dir.join("rename", newname)
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(oldfull, newfull); err != nil {
+		if e, ok := err.(*os.LinkError); ok {
+			err = e.Err
+		}
+		return &PathError{Op: "rename", Path: oldname, Err: err}
+	}
+	return nil
+}
+
+// A dirFile is a File opened by a dirFS.
+type dirFile struct {
+	f    *os.File
+	name string // name passed to Open
+}
+
+func (f *dirFile) Stat() (FileInfo, error) {
+	info, err := f.f.Stat()
+	if err != nil {
+		return nil, fixOSErr(err, f.name)
+	}
+	return fileInfoFromOS(info), nil
+}
+
+func (f *dirFile) Read(b []byte) (int, error) {
+	n, err := f.f.Read(b)
+	return n, fixOSErr(err, f.name)
+}
+
+func (f *dirFile) ReadAt(b []byte, off int64) (int, error) {
+	n, err := f.f.ReadAt(b, off)
+	return n, fixOSErr(err, f.name)
+}
+
+func (f *dirFile) Seek(offset int64, whence int) (int64, error) {
+	ret, err := f.f.Seek(offset, whence)
+	return ret, fixOSErr(err, f.name)
+}
+
+func (f *dirFile) ReadDir(n int) ([]DirEntry, error) {
+	infos, err := f.f.Readdir(n)
+	list := make([]DirEntry, len(infos))
+	for i, info := range infos {
+		list[i] = &statDirEntry{fileInfoFromOS(info)}
+	}
+	return list, fixOSErr(err, f.name)
+}
+
+func (f *dirFile) Close() error {
+	return fixOSErr(f.f.Close(), f.name)
+}
+
+// A dirWriteFile is a WritableFile created by a dirFS.
+type dirWriteFile struct {
+	dirFile
+}
+
+func (f *dirWriteFile) Write(b []byte) (int, error) {
+	n, err := f.f.Write(b)
+	return n, fixOSErr(err, f.name)
+}
+
+// fixOSErr converts an *os.PathError into a *PathError
+// whose Path is name instead of the host path.
+// Other errors, including io.EOF, are returned unchanged.
+func fixOSErr(err error, name string) error {
+	if e, ok := err.(*os.PathError); ok {
+		return &PathError{Op: e.Op, Path: name, Err: e.Err}
+	}
+	return err
+}
+
+// osFileInfo adapts an os.FileInfo to the FileInfo interface.
+type osFileInfo struct {
+	info os.FileInfo
+}
+
+func fileInfoFromOS(info os.FileInfo) FileInfo {
+	return osFileInfo{info}
+}
+
+func (i osFileInfo) Name() string       { return i.info.Name() }
+func (i osFileInfo) Size() int64        { return i.info.Size() }
+func (i osFileInfo) Mode() FileMode     { return FileMode(i.info.Mode()) }
+func (i osFileInfo) ModTime() time.Time { return i.info.ModTime() }
+func (i osFileInfo) IsDir() bool        { return i.info.IsDir() }
+func (i osFileInfo) Sys() interface{}   { return i.info.Sys() }
+
+// containsAny reports whether any of the bytes in chars are within s.
+func containsAny(s, chars string) bool {
+	for i := 0; i < len(s); i++ {
+		for j := 0; j < len(chars); j++ {
+			if s[i] == chars[j] {
+				return true
+			}
+		}
+	}
+	return false
+}
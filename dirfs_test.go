@@ -0,0 +1,79 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+// makeTree creates the named files in dir, with their names as content.
+// Names ending in a slash are created as empty directories.
+func makeTree(t *testing.T, dir string, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if name[len(name)-1] == '/' {
+			if err := os.MkdirAll(p, 0777); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(p), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(name), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestDirFS(t *testing.T) {
+	dir := t.TempDir()
+	makeTree(t, dir, "hello.txt", "sub/a.go", "sub/deep/b.go", "empty/")
+	if err := fstest.TestFS(fs.DirFS(dir), "hello.txt", "sub/a.go", "sub/deep/b.go", "empty"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDirFSInvalidNames(t *testing.T) {
+	fsys := fs.DirFS(t.TempDir())
+	for _, name := range []string{"/abs", "a/../b", "a//b", `a\b`} {
+		if _, err := fsys.Open(name); err == nil {
+			t.Errorf("Open(%q) succeeded, want error", name)
+		}
+	}
+}
+
+func TestDirFSSymlinks(t *testing.T) {
+	dir := t.TempDir()
+	makeTree(t, dir, "a.txt", "sub/b.txt")
+	makeLinks(t, dir, map[string]string{
+		"file.link":     "a.txt",
+		"dir.link":      "sub",
+		"sub/up.link":   "../a.txt",
+		"dangling.link": "missing",
+	})
+	fsys := fs.DirFS(dir)
+	if err := fstest.TestFS(fsys, "a.txt", "sub/b.txt", "sub/up.link", "file.link", "dir.link", "dangling.link"); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := fs.Lstat(fsys, "file.link")
+	if err != nil || info.Mode()&fs.ModeSymlink == 0 {
+		t.Errorf("Lstat(file.link) = %v, %v, want a symlink", info, err)
+	}
+	if target, err := fs.ReadLink(fsys, "sub/up.link"); target != "../a.txt" || err != nil {
+		t.Errorf("ReadLink(sub/up.link) = %q, %v, want %q, nil", target, err, "../a.txt")
+	}
+	if _, err := fs.ReadLink(fsys, "a.txt"); err == nil {
+		t.Errorf("ReadLink(a.txt) succeeded, want error")
+	}
+}
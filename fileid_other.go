@@ -0,0 +1,14 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !aix && !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris
+// +build !aix,!darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris
+
+package fs
+
+// sysFileID reports that files cannot be identified
+// by device and inode numbers on this system.
+func sysFileID(info FileInfo) (fileID, bool) {
+	return fileID{}, false
+}
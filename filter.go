@@ -0,0 +1,230 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"path"
+	"strings"
+)
+
+// FilterFS returns a file system (an FS) presenting the files of fsys
+// for which keep reports true.
+//
+// The function keep is called with the name of a file and a DirEntry
+// describing it, as obtained with Lstat, for every element of each name
+// used; it is never called for the root ".". A file for which keep reports
+// false, and everything below it if it is a directory, is hidden: Open,
+// Stat, Lstat, ReadLink, ReadFile and ReadDir report a *PathError wrapping
+// ErrNotExist for it, and it is omitted from the results of ReadDir, the
+// ReadDir method of directories, and Glob. Because WalkDir and the other
+// functions of this package use those operations, they see the same files.
+//
+// Symbolic links are resolved with Lstat and ReadLink before the name is
+// passed on to fsys, and keep is called for every element of the names
+// they lead to as well, so that a link cannot be used to reach a hidden
+// file: a link leading to a hidden file, or whose destination is absolute
+// or outside fsys and so cannot be checked, is listed but otherwise
+// behaves like a dangling link.
+//
+// The returned file system implements StatFS, ReadDirFS, ReadFileFS, GlobFS
+// and RecursiveGlobFS, and LstatFS and ReadLinkFS if fsys implements them.
+func FilterFS(fsys FS, keep func(name string, d DirEntry) bool) FS {
+	return wrapFS(&filterFS{fsys, keep}, fsys, wrapAll)
+}
+
+type filterFS struct {
+	fsys FS
+	keep func(name string, d DirEntry) bool
+}
+
+// check returns an error if name is invalid or hidden. Otherwise, it
+// returns name with its symbolic links resolved, except for a link in
+// its final element unless followLast is set, and the FileInfo of the
+// file it resolves to, obtained with Lstat.
+func (f *filterFS) check(op, name string, followLast bool) (string, FileInfo, error) {
+	hidden := &PathError{Op: op, Path: name, Err: ErrNotExist}
+	var info FileInfo
+	var infoName string
+	resolved, err := resolveLinks(f.fsys, op, name, followLast, ErrNotExist, func(name string, fi FileInfo) error {
+		if !f.keep(name, &statDirEntry{fi}) {
+			return hidden
+		}
+		info, infoName = fi, name
+		return nil
+	})
+	if err == nil && infoName != resolved {
+		// name is "." or a link led back to a directory already visited.
+		info, err = Lstat(f.fsys, resolved)
+	}
+	if err != nil {
+		if e, ok := err.(*PathError); ok {
+			if e.Op == op && e.Path == name {
+				return "", nil, e
+			}
+			err = e.Err
+		}
+		return "", nil, &PathError{Op: op, Path: name, Err: err}
+	}
+	return resolved, info, nil
+}
+
+// filter removes the hidden entries from the list of entries
+// of the directory dir, reusing its storage.
+func (f *filterFS) filter(dir string, list []DirEntry) []DirEntry {
+	out := list[:0]
+	for _, d := range list {
+		if f.keep(path.Join(dir, d.Name()), d) {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+func (f *filterFS) Open(name string) (File, error) {
+	resolved, _, err := f.check("open", name, true)
+	if err != nil {
+		return nil, err
+	}
+	file, err := f.fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	if d, ok := file.(ReadDirFile); ok {
+		if info, err := file.Stat(); err != nil || info.IsDir() {
+			return &filterDir{d, f, resolved}, nil
+		}
+	}
+	return file, nil
+}
+
+func (f *filterFS) Stat(name string) (FileInfo, error) {
+	if _, _, err := f.check("stat", name, true); err != nil {
+		return nil, err
+	}
+	return Stat(f.fsys, name)
+}
+
+func (f *filterFS) Lstat(name string) (FileInfo, error) {
+	_, info, err := f.check("lstat", name, false)
+	return info, err
+}
+
+func (f *filterFS) ReadLink(name string) (string, error) {
+	if _, _, err := f.check("readlink", name, false); err != nil {
+		return "", err
+	}
+	return ReadLink(f.fsys, name)
+}
+
+func (f *filterFS) ReadDir(name string) ([]DirEntry, error) {
+	resolved, _, err := f.check("readdir", name, true)
+	if err != nil {
+		return nil, err
+	}
+	list, err := ReadDir(f.fsys, name)
+	return f.filter(resolved, list), err
+}
+
+func (f *filterFS) ReadFile(name string) ([]byte, error) {
+	if _, _, err := f.check("read", name, true); err != nil {
+		return nil, err
+	}
+	return ReadFile(f.fsys, name)
+}
+
+func (f *filterFS) Glob(pattern string) ([]string, error) {
+	list, err := Glob(f.fsys, pattern)
+	return f.filterNames(list), err
+}
+
+func (f *filterFS) RecursiveGlob(pattern string) ([]string, error) {
+	list, err := RecursiveGlob(f.fsys, pattern)
+	return f.filterNames(list), err
+}
+
+// filterNames removes the hidden names from list, reusing its storage.
+// It returns nil if no name is left.
+func (f *filterFS) filterNames(list []string) []string {
+	out := list[:0]
+	for _, name := range list {
+		if _, _, err := f.check("glob", name, false); err == nil {
+			out = append(out, name)
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// A filterDir is a directory opened by a filterFS.
+type filterDir struct {
+	ReadDirFile
+	f    *filterFS
+	name string // with its symbolic links resolved
+}
+
+func (d *filterDir) ReadDir(n int) ([]DirEntry, error) {
+	for {
+		list, err := d.ReadDirFile.ReadDir(n)
+		list = d.f.filter(d.name, list)
+		// A call with n > 0 must not return an empty slice without an error,
+		// so read on if all entries of this batch were hidden.
+		if n <= 0 || len(list) > 0 || err != nil {
+			return list, err
+		}
+	}
+}
+
+// KeepExts returns a function for use with FilterFS that keeps
+// all directories and the files whose names end in one of the extensions
+// exts, as reported by path.Ext. The extensions include the leading dot,
+// as in ".go".
+func KeepExts(exts ...string) func(name string, d DirEntry) bool {
+	set := make(map[string]bool)
+	for _, ext := range exts {
+		set[ext] = true
+	}
+	return func(name string, d DirEntry) bool {
+		return d.IsDir() || set[path.Ext(name)]
+	}
+}
+
+// KeepPatterns returns a function for use with FilterFS that hides
+// the files and directories whose names match one of the patterns in exclude
+// and, if include is not empty, the files, other than directories,
+// whose names match none of the patterns in include.
+// The patterns use the syntax of MatchExt and are matched against
+// the entire name. The only possible returned error is
+// path.ErrBadPattern, reporting that a pattern is malformed.
+func KeepPatterns(include, exclude []string) (func(name string, d DirEntry) bool, error) {
+	for _, list := range [][]string{include, exclude} {
+		for _, pattern := range list {
+			if _, err := MatchExt(pattern, ""); err != nil {
+				return nil, err
+			}
+		}
+	}
+	matchAny := func(patterns []string, name string) bool {
+		for _, pattern := range patterns {
+			if matched, _ := MatchExt(pattern, name); matched {
+				return true
+			}
+		}
+		return false
+	}
+	return func(name string, d DirEntry) bool {
+		if matchAny(exclude, name) {
+			return false
+		}
+		return d.IsDir() || len(include) == 0 || matchAny(include, name)
+	}, nil
+}
+
+// KeepNoDotfiles is a function for use with FilterFS that hides
+// the files and directories whose names start with a dot.
+func KeepNoDotfiles(name string, d DirEntry) bool {
+	return !strings.HasPrefix(path.Base(name), ".")
+}
@@ -0,0 +1,82 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+func TestFilterFS(t *testing.T) {
+	fsys := fs.FilterFS(fstest.MapFS{
+		"a.go":       {Data: []byte("a")},
+		"b.txt":      {Data: []byte("b")},
+		"sub/c.go":   {Data: []byte("c")},
+		"sub/d.txt":  {Data: []byte("d")},
+		"none/e.txt": {Data: []byte("e")},
+	}, fs.KeepExts(".go"))
+	if err := fstest.TestFS(fsys, "a.go", "sub/c.go", "none"); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"b.txt", "sub/d.txt", "none/e.txt"} {
+		if _, err := fs.Stat(fsys, name); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Stat(%s) = %v, want ErrNotExist", name, err)
+		}
+	}
+}
+
+func TestFilterFSLinks(t *testing.T) {
+	dir := t.TempDir()
+	makeTree(t, dir, "pub.txt", "pub/a.txt", "pub/hide.txt", "secret/key.txt")
+	makeLinks(t, dir, map[string]string{
+		"pub.link":    "pub.txt",
+		"pubdir.link": "pub",
+		"ok.link":     "secret/key.txt",
+		"dir.link":    "secret",
+		"chain.link":  "ok.link",
+		"abs.link":    filepath.Join(dir, "pub.txt"),
+	})
+	fsys := fs.FilterFS(fs.DirFS(dir), func(name string, d fs.DirEntry) bool {
+		return name != "secret" && name != "pub/hide.txt"
+	})
+	// Links leading to hidden files, or that cannot be checked,
+	// look dangling.
+	if err := fstest.TestFS(fsys, "pub.txt", "pub/a.txt", "pub.link", "pubdir.link",
+		"ok.link", "dir.link", "chain.link", "abs.link"); err != nil {
+		t.Fatal(err)
+	}
+
+	if data, err := fs.ReadFile(fsys, "pub.link"); string(data) != "pub.txt" || err != nil {
+		t.Errorf("ReadFile(pub.link) = %q, %v, want %q, nil", data, err, "pub.txt")
+	}
+	for _, name := range []string{"ok.link", "dir.link/key.txt", "chain.link", "abs.link", "pubdir.link/hide.txt"} {
+		if _, err := fs.ReadFile(fsys, name); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("ReadFile(%s) = %v, want ErrNotExist", name, err)
+		}
+		if _, err := fs.Stat(fsys, name); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Stat(%s) = %v, want ErrNotExist", name, err)
+		}
+		if _, err := fsys.Open(name); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Open(%s) = %v, want ErrNotExist", name, err)
+		}
+	}
+	if _, err := fs.ReadDir(fsys, "dir.link"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadDir(dir.link) = %v, want ErrNotExist", err)
+	}
+	if _, err := fs.Lstat(fsys, "ok.link"); err != nil {
+		t.Errorf("Lstat(ok.link) = %v, want nil", err)
+	}
+
+	// Entries of a directory reached through a link are filtered
+	// by their names in the directory the link leads to.
+	list, err := fs.ReadDir(fsys, "pubdir.link")
+	if err != nil || len(list) != 1 || list[0].Name() != "a.txt" {
+		t.Errorf("ReadDir(pubdir.link) = %v, %v, want [a.txt]", list, err)
+	}
+}
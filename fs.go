@@ -8,6 +8,7 @@
 package fs
 
 import (
+	"errors"
 	"os"
 	"time"
 )
@@ -17,6 +18,15 @@ import (
 // The FS interface is the minimum implementation required of the file system.
 // A file system may implement additional interfaces,
 // such as ReadFileFS, to provide additional or optimized functionality.
+//
+// Each such interface has a top-level function, such as ReadFile, that uses
+// the interface if it is implemented and otherwise falls back to more
+// primitive operations or reports that the operation is not implemented.
+// A file system that wraps another should implement the additional
+// interfaces by calling these functions on the wrapped file system,
+// so that the optimizations of the wrapped file system are not lost.
+// The wrappers of this package, such as Sub, implement each additional
+// interface only if the wrapped file system supports it.
 type FS interface {
 	// Open opens the named file.
 	//
@@ -140,6 +150,12 @@ func errExist() error      { return os.ErrExist }
 func errNotExist() error   { return os.ErrNotExist }
 func errClosed() error     { return os.ErrClosed }
 
+// ErrNotImplemented is wrapped in the *PathError returned by a top-level
+// function, such as Mkdir or ReadLink, when the file system implements
+// neither the corresponding interface nor those the function can fall
+// back on.
+var ErrNotImplemented = errors.New("not implemented")
+
 // A FileInfo describes a file and is returned by Stat.
 type FileInfo interface {
 	Name() string       // base name of the file
@@ -0,0 +1,49 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fstest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/vedranvuk/fs"
+)
+
+func TestMapFS(t *testing.T) {
+	m := MapFS{
+		"hello":             {Data: []byte("hello, world\n")},
+		"fortune/k/ken.txt": {Data: []byte("If a program is too slow, it must have a loop.\n")},
+		"empty":             {Mode: fs.ModeDir | 0755},
+	}
+	if err := TestFS(m, "hello", "fortune/k/ken.txt", "empty"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMapFSChmodDot(t *testing.T) {
+	m := MapFS{
+		"a/b.txt": {},
+		".":       {Mode: 0755 | fs.ModeDir},
+	}
+	info, err := fs.Stat(m, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := info.Mode(), 0755|fs.ModeDir; got != want {
+		t.Errorf("Stat(.).Mode() = %v, want %v", got, want)
+	}
+}
+
+func TestMapFSModTime(t *testing.T) {
+	mtime := time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)
+	m := MapFS{"f": {Data: []byte("x"), ModTime: mtime}}
+	info, err := fs.Stat(m, "f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.ModTime().Equal(mtime) || info.Size() != 1 {
+		t.Errorf("Stat(f) = %v, %d, want %v, 1", info.ModTime(), info.Size(), mtime)
+	}
+}
@@ -4,10 +4,7 @@
 
 package fs
 
-import (
-	"path"
-	"runtime"
-)
+import "runtime"
 
 // A GlobFS is a file system with a Glob method.
 type GlobFS interface {
@@ -26,7 +23,7 @@ type GlobFS interface {
 //
 // Glob ignores file system errors such as I/O errors reading directories.
 // The only possible returned error is path.ErrBadPattern, reporting that
-// the pattern is malformed.
+// the pattern is malformed. GlobWithErrors reports such errors instead.
 //
 // If fs implements GlobFS, Glob calls fs.Glob.
 // Otherwise, Glob uses ReadDir to traverse the directory tree
@@ -35,42 +32,7 @@ func Glob(fsys FS, pattern string) (matches []string, err error) {
 	if fsys, ok := fsys.(GlobFS); ok {
 		return fsys.Glob(pattern)
 	}
-
-	// Check pattern is well-formed.
-	if _, err := path.Match(pattern, ""); err != nil {
-		return nil, err
-	}
-	if !hasMeta(pattern) {
-		if _, err = Stat(fsys, pattern); err != nil {
-			return nil, nil
-		}
-		return []string{pattern}, nil
-	}
-
-	dir, file := path.Split(pattern)
-	dir = cleanGlobPath(dir)
-
-	if !hasMeta(dir) {
-		return glob(fsys, dir, file, nil)
-	}
-
-	// Prevent infinite recursion. See issue 15879.
-	if dir == pattern {
-		return nil, path.ErrBadPattern
-	}
-
-	var m []string
-	m, err = Glob(fsys, dir)
-	if err != nil {
-		return
-	}
-	for _, d := range m {
-		matches, err = glob(fsys, d, file, matches)
-		if err != nil {
-			return
-		}
-	}
-	return
+	return globWithErrors(fsys, pattern, nil)
 }
 
 // cleanGlobPath prepares path for glob matching.
@@ -88,23 +50,7 @@ func cleanGlobPath(path string) string {
 // If the directory cannot be opened, glob returns the existing matches.
 // New matches are added in lexicographical order.
 func glob(fs FS, dir, pattern string, matches []string) (m []string, e error) {
-	m = matches
-	infos, err := ReadDir(fs, dir)
-	if err != nil {
-		return // ignore I/O error
-	}
-
-	for _, info := range infos {
-		n := info.Name()
-		matched, err := path.Match(pattern, n)
-		if err != nil {
-			return m, err
-		}
-		if matched {
-			m = append(m, path.Join(dir, n))
-		}
-	}
-	return
+	return globDirWithErrors(fs, dir, pattern, matches, nil)
 }
 
 // hasMeta reports whether path contains any of the magic characters
@@ -118,3 +64,25 @@ func hasMeta(path string) bool {
 	}
 	return false
 }
+
+// escapeMeta returns path with the magic characters recognized by
+// path.Match escaped, so that as a pattern it matches only itself.
+func escapeMeta(path string) string {
+	var b []byte
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		if c == '*' || c == '?' || c == '[' || c == '\\' {
+			if b == nil {
+				b = append(make([]byte, 0, 2*len(path)), path[:i]...)
+			}
+			b = append(b, '\\')
+		}
+		if b != nil {
+			b = append(b, c)
+		}
+	}
+	if b == nil {
+		return path
+	}
+	return string(b)
+}
@@ -0,0 +1,149 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"errors"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// A GlobError reports the directories that GlobWithErrors
+// could not read while matching Pattern.
+type GlobError struct {
+	Pattern string
+	Errs    []error // errors from ReadDir, one per directory, in the order encountered
+}
+
+func (e *GlobError) Error() string {
+	var b strings.Builder
+	b.WriteString("glob ")
+	b.WriteString(e.Pattern)
+	b.WriteString(": cannot read ")
+	b.WriteString(strconv.Itoa(len(e.Errs)))
+	if len(e.Errs) == 1 {
+		b.WriteString(" directory")
+	} else {
+		b.WriteString(" directories")
+	}
+	for _, err := range e.Errs {
+		b.WriteString("\n\t")
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+// Is reports whether any of the errors in e.Errs matches target,
+// so that errors.Is(err, ErrPermission) can be used on a GlobError.
+func (e *GlobError) Is(target error) bool {
+	for _, err := range e.Errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// GlobWithErrors is like Glob but does not ignore file system errors.
+// It returns all the matches it could find, together with a *GlobError
+// listing every directory that the pattern required reading but that
+// could not be read, for example because of a permission problem.
+// Directories that do not exist, and matched names that are not
+// directories, are not errors: they simply produce no matches.
+// As with Glob, a malformed pattern is reported as path.ErrBadPattern.
+//
+// Because GlobFS does not report errors, GlobWithErrors does not use
+// the Glob method of fs: it always traverses the directory tree with ReadDir.
+func GlobWithErrors(fsys FS, pattern string) (matches []string, err error) {
+	var errs []error
+	matches, err = globWithErrors(fsys, pattern, &errs)
+	if err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 {
+		return matches, &GlobError{Pattern: pattern, Errs: errs}
+	}
+	return matches, nil
+}
+
+// globWithErrors implements GlobWithErrors, appending read errors to errs.
+// If errs is nil, read errors are ignored, as by Glob.
+func globWithErrors(fsys FS, pattern string, errs *[]error) (matches []string, err error) {
+	return globPattern(fsys, pattern, func(dir, pattern string, matches []string) ([]string, error) {
+		return globDirWithErrors(fsys, dir, pattern, matches, errs)
+	})
+}
+
+// globPattern implements the matching of Glob, calling globDir to append
+// the names in the directory dir matching the final element pattern
+// to matches.
+func globPattern(fsys FS, pattern string, globDir func(dir, pattern string, matches []string) ([]string, error)) (matches []string, err error) {
+	// Check pattern is well-formed.
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	if !hasMeta(pattern) {
+		if _, err = Stat(fsys, pattern); err != nil {
+			return nil, nil
+		}
+		return []string{pattern}, nil
+	}
+
+	dir, file := path.Split(pattern)
+	dir = cleanGlobPath(dir)
+
+	if !hasMeta(dir) {
+		return globDir(dir, file, nil)
+	}
+
+	// Prevent infinite recursion. See issue 15879.
+	if dir == pattern {
+		return nil, path.ErrBadPattern
+	}
+
+	var m []string
+	m, err = globPattern(fsys, dir, globDir)
+	if err != nil {
+		return
+	}
+	for _, d := range m {
+		matches, err = globDir(d, file, matches)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// globDirWithErrors is like glob, but appends the error to errs
+// if dir exists and is a directory but cannot be read.
+// If errs is nil, it returns the existing matches in that case.
+func globDirWithErrors(fs FS, dir, pattern string, matches []string, errs *[]error) (m []string, e error) {
+	m = matches
+	infos, err := ReadDir(fs, dir)
+	if err != nil {
+		if errs == nil || errors.Is(err, ErrNotExist) {
+			return // ignore I/O error
+		}
+		if info, serr := Stat(fs, dir); serr == nil && !info.IsDir() {
+			return
+		}
+		*errs = append(*errs, err)
+		// Match the entries read before the error, if any.
+	}
+
+	for _, info := range infos {
+		n := info.Name()
+		matched, err := path.Match(pattern, n)
+		if err != nil {
+			return m, err
+		}
+		if matched {
+			m = append(m, path.Join(dir, n))
+		}
+	}
+	return
+}
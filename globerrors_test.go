@@ -0,0 +1,51 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"errors"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/vedranvuk/fs"
+)
+
+func TestGlobWithErrors(t *testing.T) {
+	fsys := &slowFS{FS: walkTree, fail: map[string]bool{"b/d": true}}
+	for _, tt := range []struct {
+		pattern string
+		want    []string
+		errs    int
+	}{
+		{"*", []string{"a", "b", "i", "m", "r"}, 0},
+		{"b/*", []string{"b/c", "b/d", "b/h"}, 0},
+		{"b/d/*", nil, 1},
+		{"*/*/*", []string{"i/j/k", "m/n/o"}, 1},
+		{"a/*", nil, 0},
+		{"x/*", nil, 0},
+		{"b/h", []string{"b/h"}, 0},
+	} {
+		matches, err := fs.Glob(fsys, tt.pattern)
+		if err != nil || !reflect.DeepEqual(matches, tt.want) {
+			t.Errorf("Glob(%#q) = %q, %v, want %q, nil", tt.pattern, matches, err, tt.want)
+		}
+		matches, err = fs.GlobWithErrors(fsys, tt.pattern)
+		if !reflect.DeepEqual(matches, tt.want) {
+			t.Errorf("GlobWithErrors(%#q) = %q, want %q", tt.pattern, matches, tt.want)
+		}
+		var gerr *fs.GlobError
+		switch {
+		case tt.errs == 0 && err != nil:
+			t.Errorf("GlobWithErrors(%#q): unexpected error %v", tt.pattern, err)
+		case tt.errs > 0 && (!errors.As(err, &gerr) || len(gerr.Errs) != tt.errs || !errors.Is(err, errReadDir)):
+			t.Errorf("GlobWithErrors(%#q): error = %v, want a GlobError with %d errors", tt.pattern, err, tt.errs)
+		}
+	}
+
+	if _, err := fs.GlobWithErrors(fsys, "[]"); err != path.ErrBadPattern {
+		t.Errorf("GlobWithErrors([]) = %v, want ErrBadPattern", err)
+	}
+}
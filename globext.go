@@ -0,0 +1,225 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"path"
+	"sort"
+	"strings"
+)
+
+// GlobExt is like RecursiveGlob, and therefore like Glob, but accepts
+// an extended pattern syntax:
+//
+//	{alt1,alt2,...}  matches any of the comma-separated alternatives,
+//	                 which may themselves contain patterns and braces
+//	[!...]           a negated character class, like [^...]
+//	[[:class:]]      within a character class, one of the named classes
+//	                 alnum, alpha, blank, digit, lower, punct, space,
+//	                 upper and xdigit, matching the ASCII characters
+//	                 of the POSIX class of the same name
+//
+// Braces are expanded before matching, so "src/{cmd,internal}/*.{go,s}"
+// is equivalent to the union of the matches of four patterns.
+// A brace, comma or exclamation mark can be escaped with a backslash.
+// Each expanded pattern is matched with RecursiveGlob, so patterns
+// without magic characters are resolved with a single Stat.
+//
+// GlobExt returns the matches of all expanded patterns,
+// sorted and without duplicates, or nil if there is no matching file.
+// The only possible returned error is path.ErrBadPattern,
+// reporting that the pattern is malformed.
+func GlobExt(fsys FS, pattern string) ([]string, error) {
+	patterns, err := expandExt(pattern)
+	if err != nil {
+		return nil, err
+	}
+	var matches []string
+	seen := make(map[string]bool)
+	for _, p := range patterns {
+		list, err := RecursiveGlob(fsys, p)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range list {
+			if !seen[name] {
+				seen[name] = true
+				matches = append(matches, name)
+			}
+		}
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
+// MatchExt reports whether name matches the extended pattern,
+// using the syntax accepted by GlobExt, including "**" elements.
+// Unlike path.Match, the pattern is matched against all of name,
+// element by element, so "*" never matches a slash.
+// The only possible returned error is path.ErrBadPattern,
+// reporting that the pattern is malformed.
+func MatchExt(pattern, name string) (matched bool, err error) {
+	patterns, err := expandExt(pattern)
+	if err != nil {
+		return false, err
+	}
+	nameElems := strings.Split(name, "/")
+	for _, p := range patterns {
+		if matchElems(strings.Split(p, "/"), nameElems, false) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// expandExt expands the braces in an extended pattern and translates
+// the character class extensions in each resulting pattern,
+// returning well-formed patterns for path.Match and RecursiveGlob.
+func expandExt(pattern string) ([]string, error) {
+	patterns, err := expandBraces(pattern)
+	if err != nil {
+		return nil, err
+	}
+	for i, p := range patterns {
+		p, err = translateClasses(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, elem := range strings.Split(p, "/") {
+			if _, err := path.Match(elem, ""); err != nil {
+				return nil, err
+			}
+		}
+		patterns[i] = p
+	}
+	return patterns, nil
+}
+
+// expandBraces returns the patterns resulting from expanding
+// every brace expression in pattern, in order of appearance.
+func expandBraces(pattern string) ([]string, error) {
+	// Find the first brace outside a character class.
+	start := -1
+	inClass := false
+Scan:
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\':
+			i++
+		case inClass:
+			inClass = c != ']'
+		case c == '[':
+			inClass = true
+		case c == '{':
+			start = i
+			break Scan
+		case c == '}':
+			return nil, path.ErrBadPattern
+		}
+	}
+	if start < 0 {
+		return []string{pattern}, nil
+	}
+
+	// Find the matching brace and the top-level commas.
+	var alts []string
+	depth := 0
+	from := start + 1
+	inClass = false
+	for i := start + 1; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\':
+			i++
+		case inClass:
+			inClass = c != ']'
+		case c == '[':
+			inClass = true
+		case c == '{':
+			depth++
+		case c == ',' && depth == 0:
+			alts = append(alts, pattern[from:i])
+			from = i + 1
+		case c == '}' && depth > 0:
+			depth--
+		case c == '}':
+			alts = append(alts, pattern[from:i])
+			prefix, suffix := pattern[:start], pattern[i+1:]
+			var patterns []string
+			for _, alt := range alts {
+				list, err := expandBraces(prefix + alt + suffix)
+				if err != nil {
+					return nil, err
+				}
+				patterns = append(patterns, list...)
+			}
+			return patterns, nil
+		}
+	}
+	return nil, path.ErrBadPattern
+}
+
+// posixClasses maps the names of the supported POSIX character classes
+// to equivalent path.Match character class contents.
+var posixClasses = map[string]string{
+	"alnum":  "0-9A-Za-z",
+	"alpha":  "A-Za-z",
+	"blank":  " \t",
+	"digit":  "0-9",
+	"lower":  "a-z",
+	"punct":  `!-.:-@\[-` + "`" + `{-~`, // without '/', which never occurs in an element
+	"space":  " \t\n\v\f\r",
+	"upper":  "A-Z",
+	"xdigit": "0-9A-Fa-f",
+}
+
+// translateClasses rewrites the character class extensions in pattern,
+// negation with '!' and named POSIX classes, into path.Match syntax.
+// It also removes the escaping of the characters only special to brace
+// expansion, which path.Match does not need.
+func translateClasses(pattern string) (string, error) {
+	var b strings.Builder
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\\':
+			if i+1 == len(pattern) {
+				return "", path.ErrBadPattern
+			}
+			i++
+			switch n := pattern[i]; {
+			case !inClass && (n == '{' || n == '}' || n == ',' || n == '!'):
+				b.WriteByte(n)
+			default:
+				b.WriteByte(c)
+				b.WriteByte(n)
+			}
+		case !inClass && c == '[':
+			inClass = true
+			b.WriteByte(c)
+			if i+1 < len(pattern) && pattern[i+1] == '!' {
+				b.WriteByte('^')
+				i++
+			}
+		case inClass && c == '[' && strings.HasPrefix(pattern[i:], "[:"):
+			end := strings.Index(pattern[i+2:], ":]")
+			if end < 0 {
+				return "", path.ErrBadPattern
+			}
+			class, ok := posixClasses[pattern[i+2:i+2+end]]
+			if !ok {
+				return "", path.ErrBadPattern
+			}
+			b.WriteString(class)
+			i += 2 + end + 1
+		case inClass && c == ']':
+			inClass = false
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String(), nil
+}
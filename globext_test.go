@@ -0,0 +1,112 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+var matchExtTests = []struct {
+	pattern, name string
+	match         bool
+	err           error
+}{
+	{"*.go", "a.go", true, nil},
+	{"*.go", "d/a.go", false, nil},
+	{"**/*.go", "d/e/a.go", true, nil},
+	{"**/*.go", "a.go", true, nil},
+	{"a/**/b", "a/b", true, nil},
+	{"a/**/b", "a/x/y/b", true, nil},
+	{"a/**", "a/x/y", true, nil},
+
+	// Braces.
+	{"*.{go,s}", "a.s", true, nil},
+	{"*.{go,s}", "a.c", false, nil},
+	{"{cmd,internal}/*.go", "internal/a.go", true, nil},
+	{"{a,b{c,d}}x", "bdx", true, nil},
+	{"{a,b{c,d}}x", "bx", false, nil},
+	{"{a,}x", "x", true, nil},
+	{"{a/b,c}/d", "a/b/d", true, nil},
+	{`\{a,b\}`, "{a,b}", true, nil},
+	{`{a\,b,c}`, "a,b", true, nil},
+	{"[{]x", "{x", true, nil},
+
+	// Character classes.
+	{"[!a]", "b", true, nil},
+	{"[!a]", "a", false, nil},
+	{"[^a]", "a", false, nil},
+	{`\!a`, "!a", true, nil},
+	{"[[:digit:]]x", "7x", true, nil},
+	{"[[:digit:]]x", "ax", false, nil},
+	{"[[:alpha:][:digit:]_]", "_", true, nil},
+	{"[![:lower:]]", "a", false, nil},
+	{"[![:lower:]]", "A", true, nil},
+	{"[[:xdigit:]][[:xdigit:]]", "fF", true, nil},
+	{"[[:space:]]", " ", true, nil},
+	{"[[:punct:]]", "!", true, nil},
+	{"[[:punct:]]", "a", false, nil},
+	{"[[:upper:]]*", "Go", true, nil},
+
+	// Malformed patterns.
+	{"{a,b", "a", false, path.ErrBadPattern},
+	{"a}", "a", false, path.ErrBadPattern},
+	{"[a", "a", false, path.ErrBadPattern},
+	{"[[:nope:]]", "a", false, path.ErrBadPattern},
+	{"[[:digit", "1", false, path.ErrBadPattern},
+	{`a\`, "a", false, path.ErrBadPattern},
+	{"{[a,b}", "a", false, path.ErrBadPattern},
+}
+
+func TestMatchExt(t *testing.T) {
+	for _, tt := range matchExtTests {
+		match, err := fs.MatchExt(tt.pattern, tt.name)
+		if match != tt.match || err != tt.err {
+			t.Errorf("MatchExt(%#q, %#q) = %v, %v, want %v, %v", tt.pattern, tt.name, match, err, tt.match, tt.err)
+		}
+	}
+}
+
+func TestGlobExt(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.txt":   {},
+		"a/b":     {},
+		"a/c/d":   {},
+		"b.go":    {},
+		"b.s":     {},
+		"x/1.go":  {},
+		"x/y.go":  {},
+		"x/z/2.s": {},
+	}
+	for _, tt := range []struct {
+		pattern string
+		want    []string
+	}{
+		{"**", []string{"a", "a.txt", "a/b", "a/c", "a/c/d", "b.go", "b.s", "x", "x/1.go", "x/y.go", "x/z", "x/z/2.s"}},
+		{"{**,x}", []string{"a", "a.txt", "a/b", "a/c", "a/c/d", "b.go", "b.s", "x", "x/1.go", "x/y.go", "x/z", "x/z/2.s"}},
+		{"*.{s,go}", []string{"b.go", "b.s"}},
+		{"{b,x/*}.{go,s}", []string{"b.go", "b.s", "x/1.go", "x/y.go"}},
+		{"{x,x/z}/*.{go,s}", []string{"x/1.go", "x/y.go", "x/z/2.s"}},
+		{"x/[[:digit:]]*", []string{"x/1.go"}},
+		{"x/[![:digit:]]*", []string{"x/y.go", "x/z"}},
+		{"**/*.s", []string{"b.s", "x/z/2.s"}},
+		{"{a,a}/b", []string{"a/b"}},
+		{"{nope,none}", nil},
+	} {
+		got, err := fs.GlobExt(fsys, tt.pattern)
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("GlobExt(%#q) = %q, %v, want %q, nil", tt.pattern, got, err, tt.want)
+		}
+	}
+	for _, pattern := range []string{"{a", "[[:nope:]]", "a/["} {
+		if _, err := fs.GlobExt(fsys, pattern); err != path.ErrBadPattern {
+			t.Errorf("GlobExt(%#q) = %v, want ErrBadPattern", pattern, err)
+		}
+	}
+}
@@ -0,0 +1,355 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package httpfs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vedranvuk/fs"
+)
+
+// FileServerOptions holds optional settings for FileServer.
+// The zero value serves files with ETags derived from their size and
+// modification time, without directory listings or precompressed content.
+type FileServerOptions struct {
+	// HashETag causes ETags to be derived from the SHA-256 hash of the
+	// content of files instead of their size and modification time.
+	// Hashes are computed on first use and remembered for as long as
+	// the size and modification time of the file do not change.
+	HashETag bool
+
+	// Precompressed causes a request for a file to be answered with
+	// the content of the file of the same name with the suffix ".br" or
+	// ".gz", if it exists and the client accepts that content encoding.
+	// The response then has the Content-Type of the requested file
+	// and the Content-Encoding "br" or "gzip".
+	Precompressed bool
+
+	// Index is the name of the file served for a request for a directory
+	// containing such a file. If Index is empty, "index.html" is used.
+	// Set it to "-" to disable index files.
+	Index string
+
+	// Listing enables directory listings. A listing is served as JSON
+	// if the request accepts "application/json", and as HTML otherwise.
+	// Without Listing, requests for directories without an index file
+	// are answered with 403 Forbidden.
+	Listing bool
+}
+
+// FileServer returns a handler that serves HTTP requests
+// with the contents of the file system fsys.
+// A nil opts is equivalent to a pointer to a zero FileServerOptions.
+//
+// The request path, cleaned and stripped of its leading slash, names the
+// file to serve. As with http.FileServer, requests for directories
+// without a trailing slash, and for files with one, are redirected.
+// Files are served with http.ServeContent, which handles conditional
+// requests using the ETag and modification time, Range requests and
+// detection of the Content-Type from the file name or its content.
+// The file is seeked with its Seek method if it implements io.Seeker,
+// and otherwise read with ReadAt if it implements io.ReaderAt;
+// other files are emulated as described for HTTPFS.
+//
+// A directory listing is built from fs.ReadDir. As JSON, it is an array
+// of objects with the fields "name", "isDir", "size", "mode" and "modTime".
+func FileServer(fsys fs.FS, opts *FileServerOptions) http.Handler {
+	if opts == nil {
+		opts = &FileServerOptions{}
+	}
+	return &fileServer{fsys: fsys, opts: *opts, hashes: make(map[string]hashEntry)}
+}
+
+type fileServer struct {
+	fsys fs.FS
+	opts FileServerOptions
+
+	mu     sync.Mutex
+	hashes map[string]hashEntry // content hashes for HashETag, by file name
+}
+
+// A hashEntry is the ETag derived from the content hash
+// of a version of a file.
+type hashEntry struct {
+	size    int64
+	modTime time.Time
+	etag    string
+}
+
+func (s *fileServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	upath := r.URL.Path
+	if !strings.HasPrefix(upath, "/") {
+		upath = "/" + upath
+	}
+	name := strings.TrimPrefix(path.Clean(upath), "/")
+	if name == "" {
+		name = "."
+	}
+
+	info, err := fs.Stat(s.fsys, name)
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+
+	if info.IsDir() {
+		if !strings.HasSuffix(r.URL.Path, "/") {
+			localRedirect(w, r, path.Base(r.URL.Path)+"/")
+			return
+		}
+		if index := s.opts.Index; index != "-" {
+			if index == "" {
+				index = "index.html"
+			}
+			iname := path.Join(name, index)
+			if iinfo, err := fs.Stat(s.fsys, iname); err == nil && !iinfo.IsDir() {
+				s.serveFile(w, r, iname, iinfo)
+				return
+			}
+		}
+		if !s.opts.Listing {
+			http.Error(w, "403 Forbidden", http.StatusForbidden)
+			return
+		}
+		s.serveDir(w, r, name)
+		return
+	}
+
+	if strings.HasSuffix(r.URL.Path, "/") {
+		localRedirect(w, r, "../"+path.Base(name))
+		return
+	}
+	s.serveFile(w, r, name, info)
+}
+
+// serveFile serves the file name, described by info,
+// or a precompressed version of it.
+func (s *fileServer) serveFile(w http.ResponseWriter, r *http.Request, name string, info fs.FileInfo) {
+	h := w.Header()
+	ctype := mime.TypeByExtension(path.Ext(name))
+	if s.opts.Precompressed {
+		h.Add("Vary", "Accept-Encoding")
+		for _, enc := range precompressed {
+			if !acceptsEncoding(r, enc.encoding) {
+				continue
+			}
+			cinfo, err := fs.Stat(s.fsys, name+enc.suffix)
+			if err != nil || cinfo.IsDir() {
+				continue
+			}
+			if ctype == "" {
+				ctype, err = s.sniff(name)
+				if err != nil {
+					serveError(w, err)
+					return
+				}
+			}
+			h.Set("Content-Encoding", enc.encoding)
+			name, info = name+enc.suffix, cinfo
+			break
+		}
+	}
+	if ctype != "" {
+		h.Set("Content-Type", ctype)
+	}
+
+	etag, err := s.etag(name, info)
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+	h.Set("Etag", etag)
+
+	file, err := s.fsys.Open(name)
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+	defer file.Close()
+	http.ServeContent(w, r, name, info.ModTime(), s.content(name, file, info.Size()))
+}
+
+// precompressed lists the content encodings of precompressed files
+// in order of preference.
+var precompressed = []struct {
+	encoding, suffix string
+}{
+	{"br", ".br"},
+	{"gzip", ".gz"},
+}
+
+// acceptsEncoding reports whether the Accept-Encoding header of r
+// includes enc with a non-zero quality.
+func acceptsEncoding(r *http.Request, enc string) bool {
+	for _, v := range r.Header["Accept-Encoding"] {
+		for _, elem := range strings.Split(v, ",") {
+			params := strings.Split(elem, ";")
+			if strings.TrimSpace(params[0]) != enc {
+				continue
+			}
+			q := 1.0
+			for _, p := range params[1:] {
+				p = strings.TrimSpace(p)
+				if strings.HasPrefix(p, "q=") {
+					q, _ = strconv.ParseFloat(p[2:], 64)
+				}
+			}
+			return q > 0
+		}
+	}
+	return false
+}
+
+// sniff returns the content type of the named file
+// as detected from its first 512 bytes.
+func (s *fileServer) sniff(name string) (string, error) {
+	file, err := s.fsys.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	var buf [512]byte
+	n, err := io.ReadFull(file, buf[:])
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+	return http.DetectContentType(buf[:n]), nil
+}
+
+// etag returns the ETag of the named file, described by info.
+func (s *fileServer) etag(name string, info fs.FileInfo) (string, error) {
+	if !s.opts.HashETag {
+		return `"` + strconv.FormatInt(info.Size(), 16) + "-" + strconv.FormatInt(info.ModTime().UnixNano(), 16) + `"`, nil
+	}
+	s.mu.Lock()
+	e, ok := s.hashes[name]
+	s.mu.Unlock()
+	if ok && e.size == info.Size() && e.modTime.Equal(info.ModTime()) {
+		return e.etag, nil
+	}
+	file, err := s.fsys.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+	tag := `"` + hex.EncodeToString(h.Sum(nil)) + `"`
+	s.mu.Lock()
+	s.hashes[name] = hashEntry{info.Size(), info.ModTime(), tag}
+	s.mu.Unlock()
+	return tag, nil
+}
+
+// content returns an io.ReadSeeker for the content of file,
+// which has the given name and size.
+func (s *fileServer) content(name string, file fs.File, size int64) io.ReadSeeker {
+	if rs, ok := file.(io.ReadSeeker); ok {
+		return rs
+	}
+	if ra, ok := file.(io.ReaderAt); ok {
+		return io.NewSectionReader(ra, 0, size)
+	}
+	return &httpFile{fsys: s.fsys, name: name, file: file}
+}
+
+// A listEntry is an entry of a JSON directory listing.
+type listEntry struct {
+	Name    string    `json:"name"`
+	IsDir   bool      `json:"isDir"`
+	Size    int64     `json:"size"`
+	Mode    string    `json:"mode"`
+	ModTime time.Time `json:"modTime"`
+}
+
+// serveDir serves a listing of the directory name.
+func (s *fileServer) serveDir(w http.ResponseWriter, r *http.Request, name string) {
+	list, err := fs.ReadDir(s.fsys, name)
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+	w.Header().Add("Vary", "Accept")
+
+	if strings.Contains(strings.Join(r.Header["Accept"], ","), "application/json") {
+		entries := make([]listEntry, 0, len(list))
+		for _, d := range list {
+			info, err := d.Info()
+			if err != nil {
+				serveError(w, err)
+				return
+			}
+			entries = append(entries, listEntry{
+				Name:    d.Name(),
+				IsDir:   d.IsDir(),
+				Size:    info.Size(),
+				Mode:    info.Mode().String(),
+				ModTime: info.ModTime(),
+			})
+		}
+		data, err := json.Marshal(entries)
+		if err != nil {
+			serveError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprintf(w, "<!doctype html>\n<meta name=\"viewport\" content=\"width=device-width\">\n<pre>\n")
+	for _, d := range list {
+		elem := d.Name()
+		if d.IsDir() {
+			elem += "/"
+		}
+		// elem may contain '?' or '#', which must be escaped to remain
+		// part of the URL path, and not indicate the start of a query
+		// string or fragment.
+		u := url.URL{Path: elem}
+		fmt.Fprintf(w, "<a href=\"%s\">%s</a>\n", u.String(), html.EscapeString(elem))
+	}
+	fmt.Fprintf(w, "</pre>\n")
+}
+
+// serveError replies to the request with the HTTP status code
+// corresponding to err.
+func serveError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		http.Error(w, "404 page not found", http.StatusNotFound)
+	case errors.Is(err, fs.ErrPermission):
+		http.Error(w, "403 Forbidden", http.StatusForbidden)
+	default:
+		http.Error(w, "500 Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+// localRedirect gives a Moved Permanently response.
+// It does not convert relative paths to absolute paths like http.Redirect does.
+func localRedirect(w http.ResponseWriter, r *http.Request, newPath string) {
+	if q := r.URL.RawQuery; q != "" {
+		newPath += "?" + q
+	}
+	w.Header().Set("Location", newPath)
+	w.WriteHeader(http.StatusMovedPermanently)
+}
@@ -0,0 +1,149 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package httpfs_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/httpfs"
+)
+
+// get serves a GET request for target with the given headers,
+// given as name-value pairs, and returns the recorded response.
+func get(h http.Handler, target string, headers ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("GET", target, nil)
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestFileServerETag(t *testing.T) {
+	for _, opts := range []*httpfs.FileServerOptions{nil, {HashETag: true}} {
+		for _, fsys := range []fs.FS{testTree, streamFS{testTree}} {
+			h := httpfs.FileServer(fsys, opts)
+			w := get(h, "/hello.txt")
+			etag := w.Header().Get("Etag")
+			if w.Code != http.StatusOK || w.Body.String() != "hello, world\n" || etag == "" {
+				t.Fatalf("%T %+v: GET = %d %q, ETag %q", fsys, opts, w.Code, w.Body, etag)
+			}
+			if opts != nil && opts.HashETag {
+				sum := sha256.Sum256([]byte("hello, world\n"))
+				if want := `"` + hex.EncodeToString(sum[:]) + `"`; etag != want {
+					t.Errorf("%T: hash ETag = %s, want %s", fsys, etag, want)
+				}
+			}
+			if w := get(h, "/hello.txt"); w.Header().Get("Etag") != etag {
+				t.Errorf("%T %+v: second ETag = %q, want %q", fsys, opts, w.Header().Get("Etag"), etag)
+			}
+			if w := get(h, "/hello.txt", "If-None-Match", etag); w.Code != http.StatusNotModified {
+				t.Errorf("%T %+v: GET with If-None-Match = %d, want 304", fsys, opts, w.Code)
+			}
+			if w := get(h, "/hello.txt", "If-None-Match", `"other"`); w.Code != http.StatusOK {
+				t.Errorf("%T %+v: GET with other If-None-Match = %d, want 200", fsys, opts, w.Code)
+			}
+		}
+	}
+}
+
+func TestFileServerRange(t *testing.T) {
+	for _, h := range []http.Handler{
+		httpfs.FileServer(testTree, nil),
+		httpfs.FileServer(streamFS{testTree}, nil),
+	} {
+		for _, tt := range []struct {
+			rng, want string
+		}{
+			{"bytes=0-9", "0123456789"},
+			{"bytes=30-", "uvwxyz"},
+			{"bytes=-3", "xyz"},
+			{"bytes=10-12", "abc"},
+		} {
+			w := get(h, "/dir/a.txt", "Range", tt.rng)
+			if w.Code != http.StatusPartialContent || w.Body.String() != tt.want {
+				t.Errorf("Range %s: %d %q, want 206 %q", tt.rng, w.Code, w.Body, tt.want)
+			}
+		}
+		if w := get(h, "/dir/a.txt", "Range", "bytes=100-"); w.Code != http.StatusRequestedRangeNotSatisfiable {
+			t.Errorf("unsatisfiable Range: %d, want 416", w.Code)
+		}
+	}
+}
+
+func TestFileServerPrecompressed(t *testing.T) {
+	h := httpfs.FileServer(testTree, &httpfs.FileServerOptions{Precompressed: true})
+	for _, tt := range []struct {
+		accept, encoding, body string
+	}{
+		{"", "", "body { color: black }\n"},
+		{"gzip", "gzip", "gzipped css"},
+		{"gzip, br", "br", "brotli css"},
+		{"br;q=0, gzip;q=0.5", "gzip", "gzipped css"},
+		{"identity", "", "body { color: black }\n"},
+	} {
+		w := get(h, "/style.css", "Accept-Encoding", tt.accept)
+		if w.Code != http.StatusOK || w.Body.String() != tt.body || w.Header().Get("Content-Encoding") != tt.encoding {
+			t.Errorf("Accept-Encoding %q: %d %q encoding %q, want 200 %q encoding %q",
+				tt.accept, w.Code, w.Body, w.Header().Get("Content-Encoding"), tt.body, tt.encoding)
+		}
+		if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/css") {
+			t.Errorf("Accept-Encoding %q: Content-Type %q, want text/css", tt.accept, ct)
+		}
+		if v := w.Header().Get("Vary"); v != "Accept-Encoding" {
+			t.Errorf("Accept-Encoding %q: Vary %q, want Accept-Encoding", tt.accept, v)
+		}
+	}
+
+	// Without the option, the precompressed files are only served as themselves.
+	h = httpfs.FileServer(testTree, nil)
+	if w := get(h, "/style.css", "Accept-Encoding", "gzip, br"); w.Header().Get("Content-Encoding") != "" {
+		t.Errorf("Precompressed unset: Content-Encoding %q, want none", w.Header().Get("Content-Encoding"))
+	}
+}
+
+func TestFileServerDirs(t *testing.T) {
+	h := httpfs.FileServer(testTree, &httpfs.FileServerOptions{Listing: true})
+	if w := get(h, "/"); w.Code != http.StatusOK || w.Body.String() != "<h1>index</h1>\n" {
+		t.Errorf("GET /: %d %q, want the index file", w.Code, w.Body)
+	}
+	if w := get(h, "/dir"); w.Code != http.StatusMovedPermanently || w.Header().Get("Location") != "dir/" {
+		t.Errorf("GET /dir: %d Location %q, want 301 to dir/", w.Code, w.Header().Get("Location"))
+	}
+	if w := get(h, "/hello.txt/"); w.Code != http.StatusMovedPermanently || w.Header().Get("Location") != "../hello.txt" {
+		t.Errorf("GET /hello.txt/: %d Location %q, want 301 to ../hello.txt", w.Code, w.Header().Get("Location"))
+	}
+	if w := get(h, "/missing"); w.Code != http.StatusNotFound {
+		t.Errorf("GET /missing: %d, want 404", w.Code)
+	}
+
+	w := get(h, "/dir/")
+	if body := w.Body.String(); w.Code != http.StatusOK || !strings.Contains(body, `<a href="a.txt">a.txt</a>`) || !strings.Contains(body, `<a href="sub/">sub/</a>`) {
+		t.Errorf("GET /dir/: %d %q, want an HTML listing", w.Code, body)
+	}
+	w = get(h, "/dir/", "Accept", "application/json")
+	var list []struct {
+		Name  string `json:"name"`
+		IsDir bool   `json:"isDir"`
+		Size  int64  `json:"size"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &list); err != nil || len(list) != 2 ||
+		list[0].Name != "a.txt" || list[0].Size != 36 || list[1].Name != "sub" || !list[1].IsDir {
+		t.Errorf("GET /dir/ as JSON: %q, %v", w.Body, err)
+	}
+
+	h = httpfs.FileServer(testTree, &httpfs.FileServerOptions{Index: "-"})
+	if w := get(h, "/"); w.Code != http.StatusForbidden {
+		t.Errorf("GET / without index and listing: %d, want 403", w.Code)
+	}
+}
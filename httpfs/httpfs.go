@@ -0,0 +1,297 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package httpfs adapts file systems to and from the http.FileSystem
+// interface of package net/http, and serves them over HTTP.
+package httpfs
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/vedranvuk/fs"
+)
+
+// HTTPFS converts fsys to an http.FileSystem implementation,
+// for use with http.FileServer and http.NewFileTransport.
+//
+// The names passed to the Open method of the result are rooted and
+// slash-separated, like "/dir/file", as cleaned by http.FileServer;
+// the leading slash is removed before the name is passed to fsys.
+// Errors are returned as *os.PathError so that os.IsNotExist and
+// os.IsPermission recognize them.
+//
+// The files returned by Open implement Seek even if the files of fsys
+// do not implement io.Seeker. Such files are then read with ReadAt if
+// they implement io.ReaderAt. Otherwise, seeking forward skips over
+// content, and seeking backward reopens the file and buffers its content
+// in memory. The Readdir method of a file uses its ReadDir method,
+// failing if the file does not implement fs.ReadDirFile.
+func HTTPFS(fsys fs.FS) http.FileSystem {
+	return httpFS{fsys}
+}
+
+type httpFS struct {
+	fsys fs.FS
+}
+
+func (f httpFS) Open(name string) (http.File, error) {
+	if name == "/" {
+		name = "."
+	} else {
+		name = strings.TrimPrefix(name, "/")
+	}
+	file, err := f.fsys.Open(name)
+	if err != nil {
+		return nil, toOSErr(err)
+	}
+	return &httpFile{fsys: f.fsys, name: name, file: file}, nil
+}
+
+// An httpFile is an http.File wrapping a File opened by an httpFS.
+type httpFile struct {
+	fsys fs.FS
+	name string
+	file fs.File
+	off  int64  // offset of the next Read, if file is not an io.Seeker
+	pos  int64  // offset of the next Read of file itself
+	buf  []byte // content of the file, once buffered
+}
+
+func (f *httpFile) Close() error {
+	return toOSErr(f.file.Close())
+}
+
+func (f *httpFile) Stat() (os.FileInfo, error) {
+	info, err := f.file.Stat()
+	if err != nil {
+		return nil, toOSErr(err)
+	}
+	return osInfo{info}, nil
+}
+
+func (f *httpFile) Read(b []byte) (int, error) {
+	if _, ok := f.file.(io.Seeker); ok {
+		n, err := f.file.Read(b)
+		return n, toOSErr(err)
+	}
+	if f.buf != nil {
+		if f.off >= int64(len(f.buf)) {
+			return 0, io.EOF
+		}
+		n := copy(b, f.buf[f.off:])
+		f.off += int64(n)
+		return n, nil
+	}
+	if r, ok := f.file.(io.ReaderAt); ok {
+		n, err := r.ReadAt(b, f.off)
+		f.off += int64(n)
+		if err == io.EOF && n > 0 {
+			err = nil
+		}
+		return n, toOSErr(err)
+	}
+	if f.off < f.pos {
+		if err := f.buffer(); err != nil {
+			return 0, err
+		}
+		return f.Read(b)
+	}
+	if f.off > f.pos {
+		n, err := io.CopyN(ioutil.Discard, f.file, f.off-f.pos)
+		f.pos += n
+		if err != nil {
+			return 0, toOSErr(err)
+		}
+	}
+	n, err := f.file.Read(b)
+	f.pos += int64(n)
+	f.off = f.pos
+	return n, toOSErr(err)
+}
+
+// buffer reads the entire content of the file into f.buf,
+// reopening it to start from the beginning.
+func (f *httpFile) buffer() error {
+	file, err := f.fsys.Open(f.name)
+	if err != nil {
+		return toOSErr(err)
+	}
+	defer file.Close()
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return toOSErr(err)
+	}
+	if data == nil {
+		data = []byte{}
+	}
+	f.buf = data
+	return nil
+}
+
+func (f *httpFile) Seek(offset int64, whence int) (int64, error) {
+	if s, ok := f.file.(io.Seeker); ok {
+		ret, err := s.Seek(offset, whence)
+		return ret, toOSErr(err)
+	}
+	switch whence {
+	case io.SeekStart:
+		// offset += 0
+	case io.SeekCurrent:
+		offset += f.off
+	case io.SeekEnd:
+		if f.buf != nil {
+			offset += int64(len(f.buf))
+			break
+		}
+		info, err := f.file.Stat()
+		if err != nil {
+			return 0, toOSErr(err)
+		}
+		offset += info.Size()
+	default:
+		return 0, &os.PathError{Op: "seek", Path: f.name, Err: os.ErrInvalid}
+	}
+	if offset < 0 {
+		return 0, &os.PathError{Op: "seek", Path: f.name, Err: os.ErrInvalid}
+	}
+	f.off = offset
+	return offset, nil
+}
+
+func (f *httpFile) Readdir(count int) ([]os.FileInfo, error) {
+	d, ok := f.file.(fs.ReadDirFile)
+	if !ok {
+		return nil, &os.PathError{Op: "readdir", Path: f.name, Err: errors.New("not implemented")}
+	}
+	list, err := d.ReadDir(count)
+	infos := make([]os.FileInfo, 0, len(list))
+	for _, entry := range list {
+		info, err := entry.Info()
+		if err != nil {
+			return infos, toOSErr(err)
+		}
+		infos = append(infos, osInfo{info})
+	}
+	return infos, toOSErr(err)
+}
+
+// FromHTTP converts hfs to a file system (an FS).
+//
+// A name passed to the file system is passed to hfs.Open with a leading
+// slash, and "." as "/". Errors of type *os.PathError are returned as
+// *fs.PathError with the Path field set to the name passed to the
+// file system. The returned files implement io.Seeker, and directories
+// implement fs.ReadDirFile using the Readdir method of the http.File.
+func FromHTTP(hfs http.FileSystem) fs.FS {
+	return fromHTTP{hfs}
+}
+
+type fromHTTP struct {
+	hfs http.FileSystem
+}
+
+func (f fromHTTP) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	hname := "/" + name
+	if name == "." {
+		hname = "/"
+	}
+	file, err := f.hfs.Open(hname)
+	if err != nil {
+		return nil, fromOSErr(err, name)
+	}
+	return &fromHTTPFile{file, name}, nil
+}
+
+// A fromHTTPFile is a File wrapping an http.File.
+type fromHTTPFile struct {
+	file http.File
+	name string
+}
+
+func (f *fromHTTPFile) Stat() (fs.FileInfo, error) {
+	info, err := f.file.Stat()
+	if err != nil {
+		return nil, fromOSErr(err, f.name)
+	}
+	return fsInfo{info}, nil
+}
+
+func (f *fromHTTPFile) Read(b []byte) (int, error) {
+	n, err := f.file.Read(b)
+	return n, fromOSErr(err, f.name)
+}
+
+func (f *fromHTTPFile) Seek(offset int64, whence int) (int64, error) {
+	ret, err := f.file.Seek(offset, whence)
+	return ret, fromOSErr(err, f.name)
+}
+
+func (f *fromHTTPFile) ReadDir(count int) ([]fs.DirEntry, error) {
+	infos, err := f.file.Readdir(count)
+	list := make([]fs.DirEntry, len(infos))
+	for i, info := range infos {
+		list[i] = infoDirEntry{fsInfo{info}}
+	}
+	return list, fromOSErr(err, f.name)
+}
+
+func (f *fromHTTPFile) Close() error {
+	return fromOSErr(f.file.Close(), f.name)
+}
+
+// toOSErr converts a *fs.PathError into an *os.PathError.
+// Other errors, including io.EOF, are returned unchanged.
+func toOSErr(err error) error {
+	if e, ok := err.(*fs.PathError); ok {
+		return &os.PathError{Op: e.Op, Path: e.Path, Err: e.Err}
+	}
+	return err
+}
+
+// fromOSErr converts an *os.PathError into a *fs.PathError
+// whose Path is name. Other errors, including io.EOF, are returned unchanged.
+func fromOSErr(err error, name string) error {
+	if e, ok := err.(*os.PathError); ok {
+		return &fs.PathError{Op: e.Op, Path: name, Err: e.Err}
+	}
+	return err
+}
+
+// osInfo adapts a fs.FileInfo to the os.FileInfo interface.
+type osInfo struct {
+	fs.FileInfo
+}
+
+func (i osInfo) Mode() os.FileMode { return os.FileMode(i.FileInfo.Mode()) }
+
+// fsInfo adapts an os.FileInfo to the fs.FileInfo interface.
+type fsInfo struct {
+	info os.FileInfo
+}
+
+func (i fsInfo) Name() string       { return i.info.Name() }
+func (i fsInfo) Size() int64        { return i.info.Size() }
+func (i fsInfo) Mode() fs.FileMode  { return fs.FileMode(i.info.Mode()) }
+func (i fsInfo) ModTime() time.Time { return i.info.ModTime() }
+func (i fsInfo) IsDir() bool        { return i.info.IsDir() }
+func (i fsInfo) Sys() interface{}   { return i.info.Sys() }
+
+// An infoDirEntry is a fs.DirEntry describing the file of a fs.FileInfo.
+type infoDirEntry struct {
+	info fs.FileInfo
+}
+
+func (d infoDirEntry) Name() string               { return d.info.Name() }
+func (d infoDirEntry) IsDir() bool                { return d.info.IsDir() }
+func (d infoDirEntry) Type() fs.FileMode          { return d.info.Mode().Type() }
+func (d infoDirEntry) Info() (fs.FileInfo, error) { return d.info, nil }
@@ -0,0 +1,133 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package httpfs_test
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+	"github.com/vedranvuk/fs/httpfs"
+)
+
+var modTime = time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+var testTree = fstest.MapFS{
+	"index.html":       {Data: []byte("<h1>index</h1>\n"), ModTime: modTime},
+	"hello.txt":        {Data: []byte("hello, world\n"), ModTime: modTime},
+	"dir/a.txt":        {Data: []byte("0123456789abcdefghijklmnopqrstuvwxyz"), ModTime: modTime},
+	"dir/sub/b.txt":    {Data: []byte("b"), ModTime: modTime},
+	"empty":            {Mode: fs.ModeDir | 0755, ModTime: modTime},
+	"style.css":        {Data: []byte("body { color: black }\n"), ModTime: modTime},
+	"style.css.gz":     {Data: []byte("gzipped css"), ModTime: modTime},
+	"style.css.br":     {Data: []byte("brotli css"), ModTime: modTime},
+	"noindex/file.txt": {Data: []byte("file"), ModTime: modTime},
+}
+
+// streamFS is a file system whose files implement neither io.Seeker
+// nor io.ReaderAt, so that they can only be read sequentially.
+type streamFS struct{ fs.FS }
+
+type streamFile struct{ f fs.File }
+
+func (f streamFile) Stat() (fs.FileInfo, error) { return f.f.Stat() }
+func (f streamFile) Read(b []byte) (int, error) { return f.f.Read(b) }
+func (f streamFile) Close() error               { return f.f.Close() }
+
+type streamDir struct {
+	streamFile
+	d fs.ReadDirFile
+}
+
+func (d streamDir) ReadDir(n int) ([]fs.DirEntry, error) { return d.d.ReadDir(n) }
+
+func (f streamFS) Open(name string) (fs.File, error) {
+	file, err := f.FS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	if d, ok := file.(fs.ReadDirFile); ok {
+		return streamDir{streamFile{file}, d}, nil
+	}
+	return streamFile{file}, nil
+}
+
+func TestFromHTTP(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"hello.txt", "dir/a.txt", "dir/sub/b.txt"} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(name), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := fstest.TestFS(httpfs.FromHTTP(http.Dir(dir)), "hello.txt", "dir/a.txt", "dir/sub/b.txt"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHTTPFSRoundTrip(t *testing.T) {
+	for _, fsys := range []fs.FS{testTree, streamFS{testTree}} {
+		if err := fstest.TestFS(httpfs.FromHTTP(httpfs.HTTPFS(fsys)), "hello.txt", "dir/a.txt", "dir/sub/b.txt", "empty"); err != nil {
+			t.Fatalf("%T: %v", fsys, err)
+		}
+	}
+}
+
+func TestHTTPFS(t *testing.T) {
+	hfs := httpfs.HTTPFS(streamFS{testTree})
+	if _, err := hfs.Open("/missing"); !os.IsNotExist(err) {
+		t.Errorf("Open(/missing) = %v, want an error satisfying os.IsNotExist", err)
+	}
+
+	// The files of streamFS cannot seek, so HTTPFS emulates Seek.
+	f, err := hfs.Open("/dir/a.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	for _, tt := range []struct {
+		offset int64
+		whence int
+		want   string
+	}{
+		{10, io.SeekStart, "abcde"},
+		{5, io.SeekCurrent, "klmno"},
+		{-5, io.SeekEnd, "vwxyz"},
+		{2, io.SeekStart, "23456"},
+	} {
+		if _, err := f.Seek(tt.offset, tt.whence); err != nil {
+			t.Fatalf("Seek(%d, %d): %v", tt.offset, tt.whence, err)
+		}
+		buf := make([]byte, 5)
+		if _, err := io.ReadFull(f, buf); err != nil || string(buf) != tt.want {
+			t.Errorf("Seek(%d, %d) then Read = %q, %v, want %q", tt.offset, tt.whence, buf, err, tt.want)
+		}
+	}
+
+	// http.FileServer serves Range requests through the emulated Seek.
+	srv := httptest.NewServer(http.FileServer(hfs))
+	defer srv.Close()
+	req, _ := http.NewRequest("GET", srv.URL+"/dir/a.txt", nil)
+	req.Header.Set("Range", "bytes=30-")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusPartialContent || string(body) != "uvwxyz" {
+		t.Errorf("GET with Range: %s %q, want 206 %q", resp.Status, body, "uvwxyz")
+	}
+}
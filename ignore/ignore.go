@@ -0,0 +1,310 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ignore implements ignore files in the syntax of .gitignore,
+// for use with fs.WalkDir and fs.Glob.
+//
+// Each line of an ignore file holds one pattern. Blank lines and lines
+// starting with '#' are skipped, and trailing spaces are removed unless
+// escaped with a backslash. A pattern starting with '!' is negated:
+// it re-includes a name excluded by a previous pattern, unless a parent
+// directory of the name is excluded. A pattern ending with '/' only
+// matches directories. A pattern containing a '/' other than a final one
+// is anchored: it is matched against the name relative to the directory
+// containing the ignore file. Other patterns match a name at any level
+// below that directory. Path elements are matched as in path.Match,
+// with character classes negated by either '^' or '!'. An element "**"
+// matches zero or more directories, and a trailing "/**" matches
+// everything inside a directory.
+//
+// Patterns in ignore files of deeper directories take precedence
+// over those of their parents, and within a file later patterns take
+// precedence over earlier ones.
+package ignore
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/vedranvuk/fs"
+)
+
+// A Pattern is a single parsed pattern of an ignore file.
+type Pattern struct {
+	Dir     string // directory containing the ignore file; "." for the root
+	Negate  bool   // pattern started with '!'
+	DirOnly bool   // pattern ended with '/'
+
+	pattern string // pattern for fs.MatchExt, relative to Dir
+}
+
+// ParsePattern parses a line of an ignore file located in directory dir.
+// It returns ok == false for blank and comment lines,
+// and an error wrapping path.ErrBadPattern for malformed patterns.
+func ParsePattern(line, dir string) (p Pattern, ok bool, err error) {
+	line = strings.TrimSuffix(line, "\r")
+	for strings.HasSuffix(line, " ") && !strings.HasSuffix(line, `\ `) {
+		line = line[:len(line)-1]
+	}
+	if line == "" || line[0] == '#' {
+		return Pattern{}, false, nil
+	}
+
+	p.Dir = dir
+	switch {
+	case line[0] == '!':
+		p.Negate = true
+		line = line[1:]
+	case strings.HasPrefix(line, `\!`), strings.HasPrefix(line, `\#`):
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		p.DirOnly = true
+		line = line[:len(line)-1]
+	}
+	if line == "" || line == "/" {
+		return Pattern{}, false, nil
+	}
+
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+	if !anchored {
+		line = "**/" + line
+	}
+	if strings.HasSuffix(line, "/**") {
+		// Everything inside, but not the directory itself.
+		line += "/*"
+	}
+
+	// Escape the characters that fs.MatchExt uses for brace expansion.
+	var b strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; c {
+		case '\\':
+			b.WriteByte(c)
+			if i+1 < len(line) {
+				i++
+				b.WriteByte(line[i])
+			}
+		case '{', '}', ',':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	p.pattern = b.String()
+	if _, err := fs.MatchExt(p.pattern, "."); err != nil {
+		return Pattern{}, false, err
+	}
+	return p, true, nil
+}
+
+// Parse parses the content of an ignore file located in directory dir.
+func Parse(data []byte, dir string) ([]Pattern, error) {
+	var list []Pattern
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		p, ok, err := ParsePattern(string(line), dir)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		if ok {
+			list = append(list, p)
+		}
+	}
+	return list, nil
+}
+
+// Match reports whether p matches name, a path in the same file system
+// as p.Dir, which is a directory if isDir is true.
+// Names outside p.Dir never match.
+// The negation of p does not affect the result.
+func (p *Pattern) Match(name string, isDir bool) bool {
+	if p.DirOnly && !isDir {
+		return false
+	}
+	rel := name
+	if p.Dir != "." {
+		if !strings.HasPrefix(name, p.Dir+"/") {
+			return false
+		}
+		rel = name[len(p.Dir)+1:]
+	}
+	matched, _ := fs.MatchExt(p.pattern, rel)
+	return matched
+}
+
+// A Matcher decides whether names are ignored,
+// according to patterns from any number of ignore files.
+// A Matcher must not be used concurrently.
+type Matcher struct {
+	dirs   map[string][]Pattern // patterns by directory
+	loaded map[string]bool      // directories loaded by Load
+}
+
+// NewMatcher returns a Matcher using the given patterns.
+func NewMatcher(patterns ...Pattern) *Matcher {
+	m := &Matcher{dirs: make(map[string][]Pattern), loaded: make(map[string]bool)}
+	m.Add(patterns...)
+	return m
+}
+
+// Add adds patterns to m. They take precedence over the patterns
+// of the same directory that were added earlier.
+func (m *Matcher) Add(patterns ...Pattern) {
+	for _, p := range patterns {
+		m.dirs[p.Dir] = append(m.dirs[p.Dir], p)
+	}
+}
+
+// Load adds the patterns of the ignore file named filename in directory dir
+// of fsys. It is not an error for the ignore file not to exist.
+// Load does nothing if it has already loaded dir.
+func (m *Matcher) Load(fsys fs.FS, dir, filename string) error {
+	if m.loaded[dir] {
+		return nil
+	}
+	m.loaded[dir] = true
+	name := filename
+	if dir != "." {
+		name = dir + "/" + filename
+	}
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	list, err := Parse(data, dir)
+	if err != nil {
+		return &fs.PathError{Op: "parse", Path: name, Err: err}
+	}
+	m.Add(list...)
+	return nil
+}
+
+// Ignored reports whether name, which is a directory if isDir is true,
+// is ignored by the patterns of its parent directories.
+// It does not consider whether a parent directory of name is itself ignored.
+func (m *Matcher) Ignored(name string, isDir bool) bool {
+	if name == "." {
+		return false
+	}
+	dir := name
+	for {
+		dir = path.Dir(dir)
+		list := m.dirs[dir]
+		for i := len(list) - 1; i >= 0; i-- {
+			if list[i].Match(name, isDir) {
+				return !list[i].Negate
+			}
+		}
+		if dir == "." {
+			return false
+		}
+	}
+}
+
+// WalkDirFunc returns a function for fs.WalkDir that calls fn for the files
+// and directories that are not ignored. When it enters a directory, it loads
+// the ignore file named filename in that directory, if any, so that it
+// applies to the directory's contents. For an ignored directory it returns
+// fs.SkipDir, so the directory is not read at all.
+//
+// If loading an ignore file fails, fn is called a second time for the
+// directory to report the error, as with a failed ReadDir.
+func (m *Matcher) WalkDirFunc(fsys fs.FS, filename string, fn fs.WalkDirFunc) fs.WalkDirFunc {
+	return func(name string, d fs.DirEntry, err error) error {
+		if d == nil {
+			return fn(name, d, err)
+		}
+		if m.Ignored(name, d.IsDir()) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if err != nil || !d.IsDir() || filename == "" {
+			return fn(name, d, err)
+		}
+		if err := fn(name, d, nil); err != nil {
+			return err
+		}
+		if err := m.Load(fsys, name, filename); err != nil {
+			// Second call, to report the error.
+			return fn(name, d, err)
+		}
+		return nil
+	}
+}
+
+// Glob returns the names of all files matching pattern, like fs.Glob,
+// omitting the names that are ignored or that are inside an ignored
+// directory. It loads the ignore files named filename in the directories
+// containing the matches and in their parents, and reports the first
+// error from loading them.
+func (m *Matcher) Glob(fsys fs.FS, pattern, filename string) ([]string, error) {
+	matches, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return nil, err
+	}
+	var list []string
+	for _, name := range matches {
+		ignored, err := m.ignoredPath(fsys, name, filename)
+		if err != nil {
+			return nil, err
+		}
+		if !ignored {
+			list = append(list, name)
+		}
+	}
+	return list, nil
+}
+
+// ignoredPath reports whether name or one of its parent directories is
+// ignored, loading the ignore files on the way.
+func (m *Matcher) ignoredPath(fsys fs.FS, name, filename string) (bool, error) {
+	if name == "." {
+		return false, nil
+	}
+	dir := "."
+	for _, elem := range strings.Split(path.Dir(name), "/") {
+		if err := m.Load(fsys, dir, filename); err != nil {
+			return false, err
+		}
+		if elem == "." {
+			break
+		}
+		dir = path.Join(dir, elem)
+		if m.Ignored(dir, true) {
+			return true, nil
+		}
+	}
+	if err := m.Load(fsys, dir, filename); err != nil {
+		return false, err
+	}
+	info, err := fs.Stat(fsys, name)
+	if err != nil {
+		return false, err
+	}
+	return m.Ignored(name, info.IsDir()), nil
+}
+
+// WalkDir walks the file tree rooted at root like fs.WalkDir, skipping the
+// files and directories ignored by the ignore files named filename, such as
+// ".gitignore", found in root and the directories below it.
+func WalkDir(fsys fs.FS, root, filename string, fn fs.WalkDirFunc) error {
+	return fs.WalkDir(fsys, root, NewMatcher().WalkDirFunc(fsys, filename, fn))
+}
+
+// Glob returns the names of all files matching pattern, like fs.Glob,
+// omitting the names ignored by the ignore files named filename.
+// See Matcher.Glob.
+func Glob(fsys fs.FS, pattern, filename string) ([]string, error) {
+	return NewMatcher().Glob(fsys, pattern, filename)
+}
@@ -0,0 +1,203 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ignore_test
+
+import (
+	"errors"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+	"github.com/vedranvuk/fs/ignore"
+)
+
+var ignoredTests = []struct {
+	patterns string
+	name     string
+	isDir    bool
+	want     bool
+}{
+	// Unanchored patterns match at any level.
+	{"*.log", "a.log", false, true},
+	{"*.log", "d/e/a.log", false, true},
+	{"*.log", "a.txt", false, false},
+	{"tmp", "d/tmp", true, true},
+
+	// Negation re-includes names excluded by earlier patterns.
+	{"*.log\n!keep.log", "keep.log", false, false},
+	{"*.log\n!keep.log", "d/keep.log", false, false},
+	{"*.log\n!keep.log", "x.log", false, true},
+	{"!keep.log\n*.log", "keep.log", false, true},
+
+	// Patterns with a leading or inner slash are anchored.
+	{"/build", "build", true, true},
+	{"/build", "d/build", true, false},
+	{"doc/*.txt", "doc/a.txt", false, true},
+	{"doc/*.txt", "x/doc/a.txt", false, false},
+	{"doc/*.txt", "doc/sub/a.txt", false, false},
+
+	// A trailing slash matches directories only.
+	{"out/", "out", true, true},
+	{"out/", "out", false, false},
+	{"out/", "d/out", true, true},
+
+	// "**" in leading, middle and trailing positions.
+	{"**/foo", "foo", false, true},
+	{"**/foo", "a/b/foo", false, true},
+	{"**/a/b", "x/y/a/b", false, true},
+	{"a/**/b", "a/b", false, true},
+	{"a/**/b", "a/x/y/b", false, true},
+	{"a/**/b", "x/a/b", false, false},
+	{"abc/**", "abc/x", false, true},
+	{"abc/**", "abc/x/y", true, true},
+	{"abc/**", "abc", true, false},
+
+	// Comments, blank lines, trailing spaces and escapes.
+	{"# x", "# x", false, false},
+	{"\n\n", "a", false, false},
+	{"trail   ", "trail", false, true},
+	{`sp\ `, "sp ", false, true},
+	{`\!imp`, "!imp", false, true},
+	{`\#c`, "#c", false, true},
+	{`\*`, "*", false, true},
+	{`\*`, "x", false, false},
+	{"{a,b}", "{a,b}", false, true},
+	{"{a,b}", "a", false, false},
+	{"[!a]x", "bx", false, true},
+	{"[^a]x", "ax", false, false},
+}
+
+func TestIgnored(t *testing.T) {
+	for _, tt := range ignoredTests {
+		list, err := ignore.Parse([]byte(tt.patterns), ".")
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.patterns, err)
+			continue
+		}
+		if got := ignore.NewMatcher(list...).Ignored(tt.name, tt.isDir); got != tt.want {
+			t.Errorf("patterns %q: Ignored(%q, %v) = %v, want %v", tt.patterns, tt.name, tt.isDir, got, tt.want)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	if _, err := ignore.Parse([]byte("ok\n[a"), "."); !errors.Is(err, path.ErrBadPattern) {
+		t.Errorf("Parse([a) = %v, want ErrBadPattern", err)
+	}
+}
+
+func TestNested(t *testing.T) {
+	m := ignore.NewMatcher()
+	for _, f := range []struct{ dir, data string }{
+		{".", "*.txt\n!*.go\ngen/"},
+		{"sub", "!keep.txt\n*.go"},
+		{"sub/deep", "!gen/"},
+	} {
+		list, err := ignore.Parse([]byte(f.data), f.dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m.Add(list...)
+	}
+	for _, tt := range []struct {
+		name  string
+		isDir bool
+		want  bool
+	}{
+		{"keep.txt", false, true},
+		{"sub/keep.txt", false, false},
+		{"sub/other.txt", false, true},
+		{"main.go", false, false},
+		{"sub/main.go", false, true},
+		{"sub/x/main.go", false, true},
+		{"other/main.go", false, false},
+		{"sub/gen", true, true},
+		{"sub/deep/gen", true, false},
+	} {
+		if got := m.Ignored(tt.name, tt.isDir); got != tt.want {
+			t.Errorf("Ignored(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// readDirFS records the directories read with ReadDir.
+type readDirFS struct {
+	fstest.MapFS
+	read []string
+}
+
+func (f *readDirFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	f.read = append(f.read, name)
+	return f.MapFS.ReadDir(name)
+}
+
+var walkTree = fstest.MapFS{
+	".gitignore":     {Data: []byte("build/\n*.log\n!important.log\n")},
+	"a.log":          {},
+	"important.log":  {},
+	"build/x.txt":    {},
+	"src/.gitignore": {Data: []byte("!a.log\ngen/\n")},
+	"src/a.log":      {},
+	"src/b.log":      {},
+	"src/main.go":    {},
+	"src/gen/g.go":   {},
+}
+
+func TestWalkDir(t *testing.T) {
+	fsys := &readDirFS{MapFS: walkTree}
+	var names []string
+	err := ignore.WalkDir(fsys, ".", ".gitignore", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		names = append(names, name)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{".", ".gitignore", "important.log", "src", "src/.gitignore", "src/a.log", "src/main.go"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("WalkDir visited %q, want %q", names, want)
+	}
+	// Ignored directories are skipped with SkipDir, without being read.
+	if want := []string{".", "src"}; !reflect.DeepEqual(fsys.read, want) {
+		t.Errorf("WalkDir read %q, want %q", fsys.read, want)
+	}
+}
+
+func TestWalkDirLoadError(t *testing.T) {
+	fsys := fstest.MapFS{
+		".gitignore": {Data: []byte("[bad\n")},
+		"a.txt":      {},
+	}
+	var errs []error
+	err := ignore.WalkDir(fsys, ".", ".gitignore", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			errs = append(errs, err)
+			return fs.SkipDir
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], path.ErrBadPattern) {
+		t.Errorf("WalkDir reported %v, want one ErrBadPattern", errs)
+	}
+}
+
+func TestGlob(t *testing.T) {
+	got, err := ignore.Glob(walkTree, "*/*", ".gitignore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"src/.gitignore", "src/a.log", "src/main.go"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Glob(*/*) = %q, want %q", got, want)
+	}
+}
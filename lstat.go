@@ -0,0 +1,29 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+// An LstatFS is a file system with an Lstat method.
+type LstatFS interface {
+	FS
+
+	// Lstat returns a FileInfo describing the named file.
+	// If the file is a symbolic link, the returned FileInfo
+	// describes the symbolic link. Lstat makes no attempt to follow the link.
+	// If there is an error, it should be of type *PathError.
+	Lstat(name string) (FileInfo, error)
+}
+
+// Lstat returns a FileInfo describing the named file from the file system,
+// without following a final symbolic link.
+//
+// If fs implements LstatFS, Lstat calls fs.Lstat.
+// Otherwise, fs is assumed not to expose symbolic links
+// and Lstat returns the result of Stat.
+func Lstat(fsys FS, name string) (FileInfo, error) {
+	if fsys, ok := fsys.(LstatFS); ok {
+		return fsys.Lstat(name)
+	}
+	return Stat(fsys, name)
+}
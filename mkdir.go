@@ -0,0 +1,91 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import "path"
+
+// A MkdirFS is a file system with a Mkdir method.
+type MkdirFS interface {
+	FS
+
+	// Mkdir creates a new directory with the specified name and permission bits.
+	// If there is an error, it should be of type *PathError.
+	Mkdir(name string, perm FileMode) error
+}
+
+// Mkdir creates a new directory in the file system fs
+// with the specified name and permission bits.
+//
+// If fs implements MkdirFS, Mkdir calls fs.Mkdir.
+// Otherwise Mkdir returns a *PathError wrapping ErrNotImplemented.
+func Mkdir(fsys FS, name string, perm FileMode) error {
+	if fsys, ok := fsys.(MkdirFS); ok {
+		return fsys.Mkdir(name, perm)
+	}
+	return &PathError{Op: "mkdir", Path: name, Err: ErrNotImplemented}
+}
+
+// A MkdirAllFS is a file system with a MkdirAll method.
+type MkdirAllFS interface {
+	FS
+
+	// MkdirAll creates a directory named name,
+	// along with any necessary parents, providing an implementation
+	// of the top-level MkdirAll function.
+	MkdirAll(name string, perm FileMode) error
+}
+
+// MkdirAll creates a directory named name, along with any necessary parents,
+// and returns nil, or else returns an error.
+// The permission bits perm are used for all directories that MkdirAll creates.
+// If name is already a directory, MkdirAll does nothing and returns nil.
+//
+// If fs implements MkdirAllFS, MkdirAll calls fs.MkdirAll.
+// Otherwise, if fs implements MkdirFS, MkdirAll uses Stat
+// and Mkdir to create each missing directory in turn.
+// Otherwise MkdirAll returns a *PathError wrapping ErrNotImplemented.
+func MkdirAll(fsys FS, name string, perm FileMode) error {
+	if fsys, ok := fsys.(MkdirAllFS); ok {
+		return fsys.MkdirAll(name, perm)
+	}
+
+	mfs, ok := fsys.(MkdirFS)
+	if !ok {
+		return &PathError{Op: "mkdir", Path: name, Err: ErrNotImplemented}
+	}
+	if !ValidPath(name) {
+		return &PathError{Op: "mkdir", Path: name, Err: ErrInvalid}
+	}
+	return mkdirAll(mfs, name, perm)
+}
+
+// mkdirAll implements MkdirAll using fsys.Mkdir.
+func mkdirAll(fsys MkdirFS, name string, perm FileMode) error {
+	// Fast path: if we can tell whether name is a directory or file, stop with success or error.
+	info, err := Stat(fsys, name)
+	if err == nil {
+		if info.IsDir() {
+			return nil
+		}
+		return &PathError{Op: "mkdir", Path: name, Err: ErrExist}
+	}
+
+	// Slow path: make sure parent exists and then call Mkdir for name.
+	if parent := path.Dir(name); parent != "." {
+		if err := mkdirAll(fsys, parent, perm); err != nil {
+			return err
+		}
+	}
+	if err := fsys.Mkdir(name, perm); err != nil {
+		// Handle arguments like "foo/." by
+		// double-checking that directory doesn't exist.
+		info, err1 := Stat(fsys, name)
+		if err1 == nil && info.IsDir() {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
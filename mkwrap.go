@@ -0,0 +1,97 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+// +build ignore
+
+// This program generates zwrap.go, which holds the types of
+// the file systems returned by wrapFS. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+// parts lists the elements of a wrapSet in the order of their bits,
+// with the types implementing them.
+var parts = []struct{ bit, part string }{
+	{"wrapGlob", "wrapGlobPart"},
+	{"wrapSub", "wrapSubPart"},
+	{"wrapLstat", "wrapLstatPart"},
+	{"wrapReadLink", "wrapReadLinkPart"},
+	{"wrapCreate", "wrapCreatePart"},
+	{"wrapWriteFile", "wrapWriteFilePart"},
+	{"wrapMkdir", "wrapMkdirPart"},
+	{"wrapMkdirAll", "wrapMkdirAllPart"},
+	{"wrapRemove", "wrapRemovePart"},
+	{"wrapRemoveAll", "wrapRemoveAllPart"},
+	{"wrapRename", "wrapRenamePart"},
+}
+
+// implied maps each element to the one it makes available through
+// the fallbacks of the top-level functions.
+var implied = map[string]string{
+	"wrapCreate": "wrapWriteFile",
+	"wrapMkdir":  "wrapMkdirAll",
+	"wrapRemove": "wrapRemoveAll",
+}
+
+func main() {
+	bit := make(map[string]int)
+	for i, p := range parts {
+		bit[p.bit] = 1 << i
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`// Code generated by mkwrap.go; DO NOT EDIT.
+
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+// wrapTypes maps each wrapSet that wrapFS can use to a function
+// returning a file system composed of the parts implementing it.
+var wrapTypes = map[wrapSet]func(o FS) FS{
+`)
+Sets:
+	for set := 0; set < 1<<len(parts); set++ {
+		for from, to := range implied {
+			if set&bit[from] != 0 && set&bit[to] == 0 {
+				continue Sets
+			}
+		}
+		bits := []string{}
+		types := []string{"wrapBase"}
+		values := []string{"wrapBase{o}"}
+		for i, p := range parts {
+			if set&(1<<i) != 0 {
+				bits = append(bits, p.bit)
+				types = append(types, p.part)
+				values = append(values, p.part+"{o}")
+			}
+		}
+		key := "0"
+		if len(bits) > 0 {
+			key = strings.Join(bits, " | ")
+		}
+		fmt.Fprintf(&buf, "\t%s: func(o FS) FS {\n\t\treturn &struct {\n\t\t\t%s\n\t\t}{%s}\n\t},\n",
+			key, strings.Join(types, "\n\t\t\t"), strings.Join(values, ", "))
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("zwrap.go", src, 0666); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"errors"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A NamespaceFS is a file system composed of other file systems
+// mounted at names within it, in the manner of a Plan 9 namespace.
+//
+// A name refers to the file at the corresponding name within the file
+// system mounted at the longest mount point that is equal to name or a
+// parent of it. Directories that lead to mount points are visible even
+// if no mounted file system provides them: ReadDir of such a directory
+// lists the entries of the file system covering it, if any, together with
+// an entry for each mount point or directory leading to one, which shadows
+// any entry of the same name. Directories without a file system of their
+// own, including the root "." when nothing is mounted there, are
+// synthesized with mode ModeDir|0555 and a zero modification time.
+//
+// Errors from mounted file systems are returned with names rewritten to
+// be relative to the namespace.
+//
+// The zero value of NamespaceFS is an empty namespace ready to use.
+// A NamespaceFS implements StatFS, ReadDirFS, ReadFileFS and GlobFS, and
+// is safe for concurrent use, including calls to Mount and Unmount.
+type NamespaceFS struct {
+	mu     sync.RWMutex
+	mounts map[string]FS
+}
+
+// Mount mounts fsys at the name dir, replacing any file system
+// already mounted there. Mounting at "." makes fsys the root
+// of the namespace.
+func (ns *NamespaceFS) Mount(dir string, fsys FS) error {
+	if !ValidPath(dir) {
+		return &PathError{Op: "mount", Path: dir, Err: ErrInvalid}
+	}
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	if ns.mounts == nil {
+		ns.mounts = make(map[string]FS)
+	}
+	ns.mounts[dir] = fsys
+	return nil
+}
+
+// Unmount removes the file system mounted at the name dir.
+func (ns *NamespaceFS) Unmount(dir string) error {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	if _, ok := ns.mounts[dir]; !ok {
+		return &PathError{Op: "unmount", Path: dir, Err: errors.New("not mounted")}
+	}
+	delete(ns.mounts, dir)
+	return nil
+}
+
+// route returns the file system mounted at the longest mount point
+// covering name, the mount point and the name relative to it.
+func (ns *NamespaceFS) route(name string) (fsys FS, dir, rel string, ok bool) {
+	ns.mu.RLock()
+	defer ns.mu.RUnlock()
+	for dir, rel = name, "."; ; {
+		if fsys, ok = ns.mounts[dir]; ok {
+			return fsys, dir, rel, true
+		}
+		if dir == "." {
+			return nil, "", "", false
+		}
+		rel = path.Join(path.Base(dir), rel)
+		dir = path.Dir(dir)
+	}
+}
+
+// children returns the sorted names of the entries of the directory name
+// that are mount points or parents of mount points, and reports whether
+// name itself is a mount point.
+func (ns *NamespaceFS) children(name string) (elems []string, mounted bool) {
+	ns.mu.RLock()
+	defer ns.mu.RUnlock()
+	seen := make(map[string]bool)
+	for dir := range ns.mounts {
+		var rest string
+		switch {
+		case dir == name:
+			mounted = true
+			continue
+		case name == ".":
+			rest = dir
+		case strings.HasPrefix(dir, name+"/"):
+			rest = dir[len(name)+1:]
+		default:
+			continue
+		}
+		if i := strings.Index(rest, "/"); i >= 0 {
+			rest = rest[:i]
+		}
+		if !seen[rest] {
+			seen[rest] = true
+			elems = append(elems, rest)
+		}
+	}
+	sort.Strings(elems)
+	return elems, mounted
+}
+
+// fixErr rewrites the name in a *PathError returned by the file system
+// mounted at dir to be relative to the namespace.
+func (ns *NamespaceFS) fixErr(err error, dir string) error {
+	if e, ok := err.(*PathError); ok && dir != "." && ValidPath(e.Path) {
+		e.Path = path.Join(dir, e.Path)
+	}
+	return err
+}
+
+func (ns *NamespaceFS) Open(name string) (File, error) {
+	if !ValidPath(name) {
+		return nil, &PathError{Op: "open", Path: name, Err: ErrInvalid}
+	}
+	if elems, mounted := ns.children(name); mounted || len(elems) > 0 || name == "." {
+		info, err := ns.stat("open", name)
+		if err != nil {
+			return nil, err
+		}
+		return &nsDir{ns: ns, name: name, info: info}, nil
+	}
+	fsys, dir, rel, ok := ns.route(name)
+	if !ok {
+		return nil, &PathError{Op: "open", Path: name, Err: ErrNotExist}
+	}
+	file, err := fsys.Open(rel)
+	return file, ns.fixErr(err, dir)
+}
+
+func (ns *NamespaceFS) Stat(name string) (FileInfo, error) {
+	if !ValidPath(name) {
+		return nil, &PathError{Op: "stat", Path: name, Err: ErrInvalid}
+	}
+	return ns.stat("stat", name)
+}
+
+// stat returns the FileInfo of the valid name, which is named
+// by the last element of name even for mount points.
+func (ns *NamespaceFS) stat(op, name string) (FileInfo, error) {
+	elems, mounted := ns.children(name)
+	fsys, dir, rel, ok := ns.route(name)
+	if ok {
+		info, err := Stat(fsys, rel)
+		switch {
+		case err == nil && (len(elems) == 0 || info.IsDir()):
+			if mounted {
+				info = &namedFileInfo{info, path.Base(name)}
+			}
+			return info, nil
+		case err != nil && (len(elems) == 0 || mounted):
+			return nil, ns.fixErr(err, dir)
+		}
+	}
+	if len(elems) > 0 || name == "." {
+		return nsDirInfo(path.Base(name)), nil
+	}
+	return nil, &PathError{Op: op, Path: name, Err: ErrNotExist}
+}
+
+func (ns *NamespaceFS) ReadDir(name string) ([]DirEntry, error) {
+	if !ValidPath(name) {
+		return nil, &PathError{Op: "readdir", Path: name, Err: ErrInvalid}
+	}
+	elems, mounted := ns.children(name)
+	var list []DirEntry
+	fsys, dir, rel, ok := ns.route(name)
+	if ok {
+		var err error
+		list, err = ReadDir(fsys, rel)
+		if err != nil && (len(elems) == 0 || mounted) {
+			return nil, ns.fixErr(err, dir)
+		}
+	} else if len(elems) == 0 && name != "." {
+		return nil, &PathError{Op: "readdir", Path: name, Err: ErrNotExist}
+	}
+	if len(elems) == 0 {
+		return list, nil
+	}
+
+	// Replace or add the entries leading to mount points.
+	index := make(map[string]int)
+	for i, d := range list {
+		index[d.Name()] = i
+	}
+	for _, elem := range elems {
+		info, err := ns.stat("readdir", path.Join(name, elem))
+		if err != nil {
+			return nil, err
+		}
+		if i, ok := index[elem]; ok {
+			list[i] = &statDirEntry{info}
+		} else {
+			list = append(list, &statDirEntry{info})
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name() < list[j].Name() })
+	return list, nil
+}
+
+func (ns *NamespaceFS) ReadFile(name string) ([]byte, error) {
+	if !ValidPath(name) {
+		return nil, &PathError{Op: "read", Path: name, Err: ErrInvalid}
+	}
+	if elems, mounted := ns.children(name); mounted || len(elems) > 0 || name == "." {
+		return nil, &PathError{Op: "read", Path: name, Err: errors.New("is a directory")}
+	}
+	fsys, dir, rel, ok := ns.route(name)
+	if !ok {
+		return nil, &PathError{Op: "read", Path: name, Err: ErrNotExist}
+	}
+	data, err := ReadFile(fsys, rel)
+	return data, ns.fixErr(err, dir)
+}
+
+func (ns *NamespaceFS) Glob(pattern string) ([]string, error) {
+	return globPattern(ns, pattern, ns.globDir)
+}
+
+// globDir appends the names in the directory dir matching pattern to matches.
+// If no mount points lie below dir, the Glob of the file system covering dir
+// does the matching.
+func (ns *NamespaceFS) globDir(dir, pattern string, matches []string) ([]string, error) {
+	if elems, _ := ns.children(dir); len(elems) > 0 {
+		return glob(ns, dir, pattern, matches)
+	}
+	fsys, mnt, rel, ok := ns.route(dir)
+	if !ok {
+		return matches, nil
+	}
+	list, err := Glob(fsys, path.Join(escapeMeta(rel), pattern))
+	if err != nil {
+		return matches, err
+	}
+	for _, name := range list {
+		matches = append(matches, path.Join(mnt, name))
+	}
+	return matches, nil
+}
+
+// A namedFileInfo is a FileInfo with a different name.
+type namedFileInfo struct {
+	FileInfo
+	name string
+}
+
+func (i *namedFileInfo) Name() string { return i.name }
+
+// An nsDirInfo is the FileInfo of a directory synthesized
+// by a NamespaceFS, named by its value.
+type nsDirInfo string
+
+func (i nsDirInfo) Name() string       { return string(i) }
+func (i nsDirInfo) Size() int64        { return 0 }
+func (i nsDirInfo) Mode() FileMode     { return ModeDir | 0555 }
+func (i nsDirInfo) ModTime() time.Time { return time.Time{} }
+func (i nsDirInfo) IsDir() bool        { return true }
+func (i nsDirInfo) Sys() interface{}   { return nil }
+
+// An nsDir is a directory opened by a NamespaceFS
+// that is a mount point or leads to one.
+type nsDir struct {
+	ns      *NamespaceFS
+	name    string
+	info    FileInfo
+	entries []DirEntry
+	read    bool
+	offset  int
+}
+
+func (d *nsDir) Stat() (FileInfo, error) { return d.info, nil }
+func (d *nsDir) Close() error            { return nil }
+func (d *nsDir) Read(b []byte) (int, error) {
+	return 0, &PathError{Op: "read", Path: d.name, Err: errors.New("is a directory")}
+}
+
+func (d *nsDir) ReadDir(count int) ([]DirEntry, error) {
+	if !d.read {
+		entries, err := d.ns.ReadDir(d.name)
+		if err != nil {
+			return nil, err
+		}
+		d.entries = entries
+		d.read = true
+	}
+	n := len(d.entries) - d.offset
+	if n == 0 && count > 0 {
+		return nil, io.EOF
+	}
+	if count > 0 && n > count {
+		n = count
+	}
+	list := make([]DirEntry, n)
+	copy(list, d.entries[d.offset:])
+	d.offset += n
+	return list, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"errors"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+func TestNamespaceFS(t *testing.T) {
+	var ns fs.NamespaceFS
+	mounts := map[string]fs.FS{
+		".": fstest.MapFS{
+			"root.txt":     {Data: []byte("root")},
+			"usr/hidden":   {Data: []byte("shadowed by the mount at usr/lib")},
+			"usr/lib":      {Data: []byte("a file covered by a mount")},
+			"usr/local.go": {Data: []byte("package local")},
+		},
+		"usr/lib": fstest.MapFS{
+			"libc.so":   {Data: []byte("libc")},
+			"go/pkg.go": {Data: []byte("package pkg")},
+		},
+		"mnt/a/b": fstest.MapFS{
+			"deep.txt": {Data: []byte("deep")},
+		},
+	}
+	for dir, fsys := range mounts {
+		if err := ns.Mount(dir, fsys); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := fstest.TestFS(&ns, "root.txt", "usr/local.go", "usr/lib/libc.so", "usr/lib/go/pkg.go", "mnt/a/b/deep.txt"); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := fs.Stat(&ns, "mnt/a")
+	if err != nil || !info.IsDir() || info.Name() != "a" {
+		t.Errorf("Stat(mnt/a) = %v, %v, want a synthesized directory", info, err)
+	}
+	_, err = fs.ReadFile(&ns, "usr/lib/missing")
+	var perr *fs.PathError
+	if !errors.As(err, &perr) || perr.Path != "usr/lib/missing" || !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadFile(usr/lib/missing) = %v, want ErrNotExist for the namespace name", err)
+	}
+
+	for pattern, want := range map[string][]string{
+		"usr/*":           {"usr/hidden", "usr/lib", "usr/local.go"},
+		"usr/lib/*":       {"usr/lib/go", "usr/lib/libc.so"},
+		"*/*/*":           {"mnt/a/b", "usr/lib/go", "usr/lib/libc.so"},
+		"*/lib/go/*.go":   {"usr/lib/go/pkg.go"},
+		"mnt/?/b/*":       {"mnt/a/b/deep.txt"},
+		"usr/lib/libc.so": {"usr/lib/libc.so"},
+		"usr/lib/*.a":     nil,
+	} {
+		if matches, err := fs.Glob(&ns, pattern); !reflect.DeepEqual(matches, want) || err != nil {
+			t.Errorf("Glob(%#q) = %q, %v, want %q, nil", pattern, matches, err, want)
+		}
+	}
+	if _, err := fs.Glob(&ns, "usr/["); err != path.ErrBadPattern {
+		t.Errorf("Glob(usr/[) error = %v, want ErrBadPattern", err)
+	}
+
+	if err := ns.Unmount("mnt/a/b"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fs.Stat(&ns, "mnt"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Stat(mnt) after Unmount = %v, want ErrNotExist", err)
+	}
+}
+
+func TestNamespaceFSZero(t *testing.T) {
+	var ns fs.NamespaceFS
+	if err := fstest.TestFS(&ns); err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	err := fs.WalkDir(&ns, ".", func(name string, d fs.DirEntry, err error) error {
+		names = append(names, name)
+		return err
+	})
+	if err != nil || len(names) != 1 || names[0] != "." {
+		t.Errorf("WalkDir of an empty namespace visited %q, %v, want only .", names, err)
+	}
+	if _, err := fs.ReadFile(&ns, "."); err == nil {
+		t.Errorf("ReadFile(.) succeeded, want error")
+	}
+}
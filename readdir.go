@@ -4,10 +4,7 @@
 
 package fs
 
-import (
-	"errors"
-	"sort"
-)
+import "sort"
 
 // ReadDirFS is the interface implemented by a file system
 // that provides an optimized implementation of ReadDir.
@@ -38,7 +35,7 @@ func ReadDir(fsys FS, name string) ([]DirEntry, error) {
 
 	dir, ok := file.(ReadDirFile)
 	if !ok {
-		return nil, &PathError{Op: "readdir", Path: name, Err: errors.New("not implemented")}
+		return nil, &PathError{Op: "readdir", Path: name, Err: ErrNotImplemented}
 	}
 
 	list, err := dir.ReadDir(-1)
@@ -0,0 +1,110 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"path"
+	"strings"
+)
+
+// A ReadLinkFS is a file system with a ReadLink method.
+type ReadLinkFS interface {
+	FS
+
+	// ReadLink returns the destination of the named symbolic link.
+	// If there is an error, it should be of type *PathError.
+	ReadLink(name string) (string, error)
+}
+
+// ReadLink returns the destination of the named symbolic link.
+// The destination is returned as stored in the link: it may be
+// relative to the directory containing the link, or absolute.
+//
+// If fs implements ReadLinkFS, ReadLink calls fs.ReadLink.
+// Otherwise ReadLink returns a *PathError wrapping ErrNotImplemented.
+func ReadLink(fsys FS, name string) (string, error) {
+	if fsys, ok := fsys.(ReadLinkFS); ok {
+		return fsys.ReadLink(name)
+	}
+	return "", &PathError{Op: "readlink", Path: name, Err: ErrNotImplemented}
+}
+
+// evalSymlinks returns name after the evaluation of any symbolic links,
+// using Lstat and ReadLink on fsys to inspect each element of name.
+// Links whose destination is absolute, or which lead outside
+// the root of fsys, cannot be evaluated and cause an error.
+func evalSymlinks(fsys FS, name string) (string, error) {
+	return resolveLinks(fsys, "evalsymlinks", name, true, ErrInvalid, nil)
+}
+
+// resolveLinks is like evalSymlinks, but leaves a symbolic link
+// in the final element of name unresolved unless followLast is set,
+// and reports links whose destination is absolute or outside
+// the root of fsys with a *PathError wrapping escapeErr.
+// If visit is not nil, it is called with the name and FileInfo,
+// obtained with Lstat, of every file passed through, including
+// the links and the files they lead to, and an error it returns
+// stops the resolution and is returned.
+func resolveLinks(fsys FS, op, name string, followLast bool, escapeErr error, visit func(name string, info FileInfo) error) (string, error) {
+	const maxLinks = 255 // like the limit in path/filepath
+	if !ValidPath(name) {
+		return "", &PathError{Op: op, Path: name, Err: ErrInvalid}
+	}
+	links := 0
+	resolved := "."
+	rest := name
+	for rest != "" {
+		elem := rest
+		if i := strings.IndexByte(rest, '/'); i >= 0 {
+			elem, rest = rest[:i], rest[i+1:]
+		} else {
+			rest = ""
+		}
+		switch elem {
+		case "", ".":
+			continue
+		case "..":
+			if resolved == "." {
+				return "", &PathError{Op: op, Path: name, Err: escapeErr}
+			}
+			resolved = path.Dir(resolved)
+			continue
+		}
+
+		next := path.Join(resolved, elem)
+		info, err := Lstat(fsys, next)
+		if err != nil {
+			return "", err
+		}
+		if visit != nil {
+			if err := visit(next, info); err != nil {
+				return "", err
+			}
+		}
+		if info.Mode()&ModeSymlink == 0 || rest == "" && !followLast {
+			resolved = next
+			continue
+		}
+
+		if links++; links > maxLinks {
+			return "", &PathError{Op: op, Path: name, Err: ErrSymlinkLoop}
+		}
+		target, err := ReadLink(fsys, next)
+		if err != nil {
+			return "", err
+		}
+		if target == "" {
+			return "", &PathError{Op: op, Path: name, Err: ErrInvalid}
+		}
+		if path.IsAbs(target) {
+			return "", &PathError{Op: op, Path: name, Err: escapeErr}
+		}
+		if rest != "" {
+			target += "/" + rest
+		}
+		rest = target
+	}
+	return resolved, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"path"
+	"strings"
+)
+
+// A RecursiveGlobFS is a file system with a RecursiveGlob method.
+type RecursiveGlobFS interface {
+	FS
+
+	// RecursiveGlob returns the names of all files matching pattern,
+	// providing an implementation of the top-level
+	// RecursiveGlob function.
+	RecursiveGlob(pattern string) ([]string, error)
+}
+
+// RecursiveGlob returns the names of all files matching pattern or nil
+// if there is no matching file. The syntax of patterns is that of Glob,
+// extended so that a path element consisting of exactly "**" matches
+// any sequence of zero or more path elements. For example, "assets/**/*.png"
+// matches "assets/logo.png" as well as "assets/img/icons/logo.png".
+// Within a longer element, such as "a**", "**" means the same as "*".
+// Because "**" can match zero elements, the pattern "a/**" matches "a" itself.
+//
+// Matches are returned in the lexical order in which WalkDir visits them.
+//
+// Like Glob, RecursiveGlob ignores file system errors such as I/O errors
+// reading directories. The only possible returned error is path.ErrBadPattern,
+// reporting that the pattern is malformed.
+//
+// If fs implements RecursiveGlobFS, RecursiveGlob calls fs.RecursiveGlob.
+// Otherwise, if pattern contains no "**" element, RecursiveGlob calls Glob.
+// Otherwise, RecursiveGlob uses WalkDir to traverse the directory tree
+// below the longest leading part of pattern free of magic characters,
+// skipping directories that cannot contain a match.
+func RecursiveGlob(fsys FS, pattern string) (matches []string, err error) {
+	if fsys, ok := fsys.(RecursiveGlobFS); ok {
+		return fsys.RecursiveGlob(pattern)
+	}
+
+	// Check pattern is well-formed.
+	elems := strings.Split(pattern, "/")
+	recursive := false
+	for _, elem := range elems {
+		if _, err := path.Match(elem, ""); err != nil {
+			return nil, err
+		}
+		if elem == "**" {
+			recursive = true
+		}
+	}
+	if !recursive {
+		return Glob(fsys, pattern)
+	}
+
+	// Walk from the longest leading literal directory.
+	i := 0
+	for i < len(elems)-1 && elems[i] != "**" && !hasMeta(elems[i]) {
+		i++
+	}
+	root := "."
+	if i > 0 {
+		root = strings.Join(elems[:i], "/")
+	}
+
+	WalkDir(fsys, root, func(name string, d DirEntry, err error) error {
+		if err != nil {
+			return nil // ignore I/O error
+		}
+		var nameElems []string
+		if name != "." {
+			nameElems = strings.Split(name, "/")
+			if matchElems(elems, nameElems, false) {
+				matches = append(matches, name)
+			}
+		}
+		if d.IsDir() && !matchElems(elems, nameElems, true) {
+			return SkipDir
+		}
+		return nil
+	})
+	return matches, nil
+}
+
+// matchElems reports whether the path elements name match the pattern
+// elements pat, in which an element "**" matches zero or more elements.
+// If prefix is true, matchElems instead reports whether name
+// could be the leading elements of a matching path.
+// The pattern elements must be well-formed.
+func matchElems(pat, name []string, prefix bool) bool {
+	for len(pat) > 0 {
+		if pat[0] == "**" {
+			for len(pat) > 1 && pat[1] == "**" {
+				pat = pat[1:]
+			}
+			for i := 0; i <= len(name); i++ {
+				if matchElems(pat[1:], name[i:], prefix) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return prefix
+		}
+		if matched, _ := path.Match(pat[0], name[0]); !matched {
+			return false
+		}
+		pat, name = pat[1:], name[1:]
+	}
+	return len(name) == 0
+}
@@ -0,0 +1,107 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+var recursiveGlobTree = fstest.MapFS{
+	"x.go":       {},
+	"a/b/c.go":   {},
+	"a/b/d.txt":  {},
+	"a/e.go":     {},
+	"a/x/b/f.go": {},
+	"b/g.go":     {},
+	"m[1]/h.go":  {},
+}
+
+func TestRecursiveGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    []string
+	}{
+		{"**/*.go", []string{"a/b/c.go", "a/e.go", "a/x/b/f.go", "b/g.go", "m[1]/h.go", "x.go"}},
+		{"**/b", []string{"a/b", "a/x/b", "b"}},
+		{"**/b/*.go", []string{"a/b/c.go", "a/x/b/f.go", "b/g.go"}},
+		{"a/**/*.go", []string{"a/b/c.go", "a/e.go", "a/x/b/f.go"}},
+		{"a/**/b", []string{"a/b", "a/x/b"}},
+		{"a/**/**/b", []string{"a/b", "a/x/b"}},
+		{"a/**", []string{"a", "a/b", "a/b/c.go", "a/b/d.txt", "a/e.go", "a/x", "a/x/b", "a/x/b/f.go"}},
+		{"a/x/**", []string{"a/x", "a/x/b", "a/x/b/f.go"}},
+		{"*/**/*.txt", []string{"a/b/d.txt"}},
+		{"a/*.go", []string{"a/e.go"}},
+		{"a**", []string{"a"}},
+		{"**/*.c", nil},
+		{"missing/**", nil},
+	}
+	for _, tt := range tests {
+		matches, err := fs.RecursiveGlob(recursiveGlobTree, tt.pattern)
+		if err != nil {
+			t.Errorf("RecursiveGlob(%#q): %v", tt.pattern, err)
+			continue
+		}
+		if !reflect.DeepEqual(matches, tt.want) {
+			t.Errorf("RecursiveGlob(%#q) = %q, want %q", tt.pattern, matches, tt.want)
+		}
+	}
+
+	for _, pattern := range []string{"[", "**/[", "a/**/b\\"} {
+		if _, err := fs.RecursiveGlob(recursiveGlobTree, pattern); err != path.ErrBadPattern {
+			t.Errorf("RecursiveGlob(%#q) error = %v, want ErrBadPattern", pattern, err)
+		}
+	}
+}
+
+// recursiveGlobFS records the patterns passed to its RecursiveGlob method.
+type recursiveGlobFS struct {
+	openOnly
+	patterns []string
+}
+
+func (f *recursiveGlobFS) RecursiveGlob(pattern string) ([]string, error) {
+	f.patterns = append(f.patterns, pattern)
+	return fs.RecursiveGlob(f.openOnly, pattern)
+}
+
+func TestRecursiveGlobSub(t *testing.T) {
+	tests := []struct {
+		dir     string
+		pattern string
+		inner   string // pattern passed to the inner RecursiveGlob
+		want    []string
+	}{
+		{"a", "**/*.go", "a/**/*.go", []string{"b/c.go", "e.go", "x/b/f.go"}},
+		{"a", "**/b", "a/**/b", []string{"b", "x/b"}},
+		{"a/x", "**", "a/x/**", []string{"b", "b/f.go"}},
+		{"m[1]", "**/*.go", "m\\[1]/**/*.go", []string{"h.go"}},
+	}
+	for _, tt := range tests {
+		inner := &recursiveGlobFS{openOnly: openOnly{recursiveGlobTree}}
+		sub, err := fs.Sub(inner, tt.dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := sub.(fs.RecursiveGlobFS); !ok {
+			t.Fatalf("Sub(%s) does not implement RecursiveGlobFS", tt.dir)
+		}
+		matches, err := fs.RecursiveGlob(sub, tt.pattern)
+		if err != nil {
+			t.Errorf("RecursiveGlob(Sub(%s), %#q): %v", tt.dir, tt.pattern, err)
+			continue
+		}
+		if !reflect.DeepEqual(matches, tt.want) {
+			t.Errorf("RecursiveGlob(Sub(%s), %#q) = %q, want %q", tt.dir, tt.pattern, matches, tt.want)
+		}
+		if want := []string{tt.inner}; !reflect.DeepEqual(inner.patterns, want) {
+			t.Errorf("RecursiveGlob(Sub(%s), %#q) called RecursiveGlob(%q), want %q", tt.dir, tt.pattern, inner.patterns, want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"errors"
+	"path"
+)
+
+// A RemoveFS is a file system with a Remove method.
+type RemoveFS interface {
+	FS
+
+	// Remove removes the named file or (empty) directory.
+	// If there is an error, it should be of type *PathError.
+	Remove(name string) error
+}
+
+// Remove removes the named file or (empty) directory from the file system fs.
+//
+// If fs implements RemoveFS, Remove calls fs.Remove.
+// Otherwise Remove returns a *PathError wrapping ErrNotImplemented.
+func Remove(fsys FS, name string) error {
+	if fsys, ok := fsys.(RemoveFS); ok {
+		return fsys.Remove(name)
+	}
+	return &PathError{Op: "remove", Path: name, Err: ErrNotImplemented}
+}
+
+// A RemoveAllFS is a file system with a RemoveAll method.
+type RemoveAllFS interface {
+	FS
+
+	// RemoveAll removes name and any children it contains,
+	// providing an implementation of the top-level RemoveAll function.
+	RemoveAll(name string) error
+}
+
+// RemoveAll removes name and any children it contains.
+// It removes everything it can but returns the first error
+// it encounters. If name does not exist, RemoveAll
+// returns nil (no error).
+// The root directory "." cannot be removed.
+//
+// If fs implements RemoveAllFS, RemoveAll calls fs.RemoveAll.
+// Otherwise, if fs implements RemoveFS, RemoveAll uses ReadDir
+// to find the children of each directory and Remove to delete them.
+// Symbolic links are removed, not followed.
+// Otherwise RemoveAll returns a *PathError wrapping ErrNotImplemented.
+func RemoveAll(fsys FS, name string) error {
+	if fsys, ok := fsys.(RemoveAllFS); ok {
+		return fsys.RemoveAll(name)
+	}
+
+	rfs, ok := fsys.(RemoveFS)
+	if !ok {
+		return &PathError{Op: "RemoveAll", Path: name, Err: ErrNotImplemented}
+	}
+	if !ValidPath(name) || name == "." {
+		return &PathError{Op: "RemoveAll", Path: name, Err: ErrInvalid}
+	}
+	return removeAll(rfs, name)
+}
+
+// removeAll implements RemoveAll using fsys.Remove.
+func removeAll(fsys RemoveFS, name string) error {
+	// Simple case: if Remove works, we're done.
+	err := fsys.Remove(name)
+	if err == nil || errors.Is(err, ErrNotExist) {
+		return nil
+	}
+
+	// Otherwise, is this a directory we need to recurse into?
+	info, serr := Lstat(fsys, name)
+	if serr != nil {
+		if errors.Is(serr, ErrNotExist) {
+			return nil
+		}
+		return serr
+	}
+	if !info.IsDir() {
+		// Not a directory; return the error from Remove.
+		return err
+	}
+
+	// Remove contents, keeping the first error.
+	list, err := ReadDir(fsys, name)
+	for _, d := range list {
+		if err1 := removeAll(fsys, path.Join(name, d.Name())); err1 != nil && err == nil {
+			err = err1
+		}
+	}
+
+	// Remove directory.
+	err1 := fsys.Remove(name)
+	if err1 == nil || errors.Is(err1, ErrNotExist) {
+		return nil
+	}
+	if err == nil {
+		err = err1
+	}
+	return err
+}
@@ -0,0 +1,28 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+// A RenameFS is a file system with a Rename method.
+type RenameFS interface {
+	FS
+
+	// Rename renames (moves) oldname to newname.
+	// If newname already exists and is not a directory, Rename replaces it.
+	// If there is an error, it should be of type *PathError
+	// with the Path field set to oldname.
+	Rename(oldname, newname string) error
+}
+
+// Rename renames (moves) oldname to newname in the file system fs.
+// If newname already exists and is not a directory, Rename replaces it.
+//
+// If fs implements RenameFS, Rename calls fs.Rename.
+// Otherwise Rename returns a *PathError wrapping ErrNotImplemented.
+func Rename(fsys FS, oldname, newname string) error {
+	if fsys, ok := fsys.(RenameFS); ok {
+		return fsys.Rename(oldname, newname)
+	}
+	return &PathError{Op: "rename", Path: oldname, Err: ErrNotImplemented}
+}
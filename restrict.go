@@ -0,0 +1,197 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import "io"
+
+// Capabilities is a set of optional interfaces that a file system
+// returned by Restrict may implement.
+type Capabilities uint
+
+const (
+	// CapGlob allows GlobFS and RecursiveGlobFS.
+	CapGlob Capabilities = 1 << iota
+
+	// CapSub allows SubFS. The file systems returned by Sub
+	// are restricted to the same capabilities.
+	CapSub
+
+	// CapSymlinks allows LstatFS and ReadLinkFS.
+	CapSymlinks
+
+	// CapWrite allows CreateFS, WriteFileFS, MkdirFS, MkdirAllFS,
+	// RemoveFS, RemoveAllFS and RenameFS.
+	CapWrite
+
+	// CapReadOnly allows all the capabilities that do not modify
+	// the file system.
+	CapReadOnly = CapGlob | CapSub | CapSymlinks
+
+	// CapAll allows all capabilities.
+	CapAll = CapReadOnly | CapWrite
+)
+
+// Restrict returns a file system (an FS) that provides access to fsys
+// through the optional interfaces in allow only.
+//
+// The returned file system implements StatFS, ReadDirFS and ReadFileFS,
+// which provide nothing beyond what Open provides, and each of the
+// interfaces allowed by allow that fsys supports, as Sub does; it does not
+// implement any other interface, regardless of the interfaces implemented
+// by fsys. Each implemented method calls the corresponding top-level
+// function on fsys, so the optimizations of fsys are kept.
+//
+// The files returned by Open are read-only, regardless of fsys:
+// their Write method fails with a *PathError wrapping ErrPermission.
+// Directories implement ReadDirFile, and other files implement
+// io.Seeker and io.ReaderAt if the files of fsys implement both.
+// Files opened by Open never expose the files of fsys, which could
+// implement other interfaces.
+//
+// Without CapSub, Sub returns its generic implementation, which forwards
+// all operations to the returned file system and so cannot perform
+// operations that are not allowed either.
+func Restrict(fsys FS, allow Capabilities) FS {
+	var set wrapSet
+	if allow&CapGlob != 0 {
+		set |= wrapGlob
+	}
+	if allow&CapSub != 0 {
+		set |= wrapSub
+	}
+	if allow&CapSymlinks != 0 {
+		set |= wrapLinks
+	}
+	if allow&CapWrite != 0 {
+		set |= wrapWrite
+	}
+	return wrapFS(&restrictFS{fsys, allow}, fsys, set)
+}
+
+type restrictFS struct {
+	fsys  FS
+	allow Capabilities
+}
+
+func (r *restrictFS) Open(name string) (File, error) {
+	file, err := r.fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	rf := restrictFile{file, name}
+	if d, ok := file.(ReadDirFile); ok {
+		if info, err := file.Stat(); err != nil || info.IsDir() {
+			return &restrictDir{rf, d}, nil
+		}
+	}
+	if s, ok := file.(io.Seeker); ok {
+		if ra, ok := file.(io.ReaderAt); ok {
+			return &restrictSeekFile{rf, s, ra}, nil
+		}
+	}
+	return &rf, nil
+}
+
+func (r *restrictFS) Stat(name string) (FileInfo, error) {
+	return Stat(r.fsys, name)
+}
+
+func (r *restrictFS) ReadDir(name string) ([]DirEntry, error) {
+	return ReadDir(r.fsys, name)
+}
+
+func (r *restrictFS) ReadFile(name string) ([]byte, error) {
+	return ReadFile(r.fsys, name)
+}
+
+func (r *restrictFS) Glob(pattern string) ([]string, error) {
+	return Glob(r.fsys, pattern)
+}
+
+func (r *restrictFS) RecursiveGlob(pattern string) ([]string, error) {
+	return RecursiveGlob(r.fsys, pattern)
+}
+
+func (r *restrictFS) Sub(dir string) (FS, error) {
+	sub, err := Sub(r.fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	return Restrict(sub, r.allow), nil
+}
+
+func (r *restrictFS) Lstat(name string) (FileInfo, error) {
+	return Lstat(r.fsys, name)
+}
+
+func (r *restrictFS) ReadLink(name string) (string, error) {
+	return ReadLink(r.fsys, name)
+}
+
+func (r *restrictFS) Create(name string, perm FileMode) (WritableFile, error) {
+	return Create(r.fsys, name, perm)
+}
+
+func (r *restrictFS) WriteFile(name string, data []byte, perm FileMode) error {
+	return WriteFile(r.fsys, name, data, perm)
+}
+
+func (r *restrictFS) Mkdir(name string, perm FileMode) error {
+	return Mkdir(r.fsys, name, perm)
+}
+
+func (r *restrictFS) MkdirAll(name string, perm FileMode) error {
+	return MkdirAll(r.fsys, name, perm)
+}
+
+func (r *restrictFS) Remove(name string) error {
+	return Remove(r.fsys, name)
+}
+
+func (r *restrictFS) RemoveAll(name string) error {
+	return RemoveAll(r.fsys, name)
+}
+
+func (r *restrictFS) Rename(oldname, newname string) error {
+	return Rename(r.fsys, oldname, newname)
+}
+
+// A restrictFile is a read-only file opened by a restricted file system.
+type restrictFile struct {
+	f    File
+	name string
+}
+
+func (f *restrictFile) Stat() (FileInfo, error)    { return f.f.Stat() }
+func (f *restrictFile) Read(b []byte) (int, error) { return f.f.Read(b) }
+func (f *restrictFile) Close() error               { return f.f.Close() }
+
+func (f *restrictFile) Write(b []byte) (int, error) {
+	return 0, &PathError{Op: "write", Path: f.name, Err: ErrPermission}
+}
+
+// A restrictDir is a directory opened by a restricted file system.
+type restrictDir struct {
+	restrictFile
+	d ReadDirFile
+}
+
+func (f *restrictDir) ReadDir(n int) ([]DirEntry, error) { return f.d.ReadDir(n) }
+
+// A restrictSeekFile is a file opened by a restricted file system
+// that supports random access.
+type restrictSeekFile struct {
+	restrictFile
+	s  io.Seeker
+	ra io.ReaderAt
+}
+
+func (f *restrictSeekFile) Seek(offset int64, whence int) (int64, error) {
+	return f.s.Seek(offset, whence)
+}
+
+func (f *restrictSeekFile) ReadAt(b []byte, off int64) (int, error) {
+	return f.ra.ReadAt(b, off)
+}
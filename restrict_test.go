@@ -0,0 +1,103 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+func TestRestrict(t *testing.T) {
+	dir := t.TempDir()
+	makeTree(t, dir, "a.txt", "sub/b.txt", "empty/")
+	makeLinks(t, dir, map[string]string{"sub/up.link": "../a.txt"})
+	readOnly := []string{"Stat", "ReadDir", "ReadFile"}
+	links := []string{"Lstat", "ReadLink"}
+	writes := []string{"Create", "WriteFile", "Mkdir", "MkdirAll", "Remove", "RemoveAll", "Rename"}
+	for _, tt := range []struct {
+		allow fs.Capabilities
+		want  [][]string
+	}{
+		{0, [][]string{readOnly}},
+		{fs.CapGlob, [][]string{readOnly, {"Glob"}}},
+		{fs.CapSub | fs.CapSymlinks, [][]string{readOnly, {"Sub"}, links}},
+		{fs.CapWrite, [][]string{readOnly, writes}},
+		{fs.CapReadOnly, [][]string{readOnly, {"Glob", "Sub"}, links}},
+		{fs.CapAll, [][]string{readOnly, {"Glob", "Sub"}, links, writes}},
+	} {
+		fsys := fs.Restrict(fs.DirFS(dir), tt.allow)
+		if err := fstest.TestFS(fsys, "a.txt", "sub/b.txt", "sub/up.link", "empty"); err != nil {
+			t.Fatalf("allow=%b: %v", tt.allow, err)
+		}
+		want := make(map[string]bool)
+		for _, list := range tt.want {
+			for _, name := range list {
+				want[name] = true
+			}
+		}
+		for name, ok := range interfaces(fsys) {
+			if ok != want[name] {
+				t.Errorf("allow=%b: implements %sFS = %v, want %v", tt.allow, name, ok, want[name])
+			}
+		}
+
+		sub, err := fs.Sub(fsys, "sub")
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, lstat := sub.(fs.LstatFS)
+		_, write := sub.(fs.WriteFileFS)
+		if lstat != want["Lstat"] || write != want["WriteFile"] {
+			t.Errorf("allow=%b: Sub implements LstatFS = %v, WriteFileFS = %v, want %v, %v",
+				tt.allow, lstat, write, want["Lstat"], want["WriteFile"])
+		}
+	}
+}
+
+func TestRestrictSupported(t *testing.T) {
+	fsys := fs.Restrict(writeFileOnly{openOnly{fstest.MapFS{"a.txt": {}}}}, fs.CapAll)
+	want := map[string]bool{"Stat": true, "ReadDir": true, "ReadFile": true, "Glob": true, "Sub": true, "WriteFile": true}
+	for name, ok := range interfaces(fsys) {
+		if ok != want[name] {
+			t.Errorf("implements %sFS = %v, want %v", name, ok, want[name])
+		}
+	}
+}
+
+func TestRestrictFiles(t *testing.T) {
+	dir := t.TempDir()
+	makeTree(t, dir, "a.txt", "sub/b.txt")
+	fsys := fs.Restrict(fs.DirFS(dir), fs.CapAll)
+
+	f, err := fsys.Open("a.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if w, ok := f.(io.Writer); !ok {
+		t.Errorf("file does not implement io.Writer")
+	} else if _, err := w.Write([]byte("x")); !errors.Is(err, fs.ErrPermission) {
+		t.Errorf("Write = %v, want ErrPermission", err)
+	}
+	if _, ok := f.(io.ReaderAt); !ok {
+		t.Errorf("file does not implement io.ReaderAt")
+	}
+	if _, ok := f.(fs.ReadDirFile); ok {
+		t.Errorf("regular file implements ReadDirFile")
+	}
+
+	d, err := fsys.Open("sub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	if _, ok := d.(fs.ReadDirFile); !ok {
+		t.Errorf("directory does not implement ReadDirFile")
+	}
+}
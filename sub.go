@@ -7,6 +7,7 @@ package fs
 import (
 	"errors"
 	"path"
+	"strings"
 )
 
 // A SubFS is a file system with a Sub method.
@@ -23,7 +24,16 @@ type SubFS interface {
 // Otherwise, if dir is ".", Sub returns fsys unchanged.
 // Otherwise, Sub returns a new FS implementation sub that,
 // in effect, implements sub.Open(dir) as fsys.Open(path.Join(dir, name)).
-// The implementation also translates calls to ReadDir, ReadFile, and Glob appropriately.
+// The implementation also translates calls to Stat, ReadDir, ReadFile, Glob,
+// RecursiveGlob and Sub appropriately. It translates calls to each of the
+// other optional interfaces, such as Lstat or WriteFile, only if fsys
+// implements that interface, or one from which the top-level function
+// can perform the operation, as CreateFS for WriteFile. Otherwise sub does
+// not implement the interface, so that type assertions on sub report what
+// fsys supports. Each call is forwarded to the corresponding top-level
+// function on fsys, so the optimizations that fsys provides are kept.
+// Sub of the returned file system calls Sub on fsys with the combined
+// prefix instead of stacking another layer.
 //
 // Note that Sub(os.DirFS("/"), "prefix") is equivalent to os.DirFS("/prefix")
 // and that neither of them guarantees to avoid operating system
@@ -41,7 +51,7 @@ func Sub(fsys FS, dir string) (FS, error) {
 	if fsys, ok := fsys.(SubFS); ok {
 		return fsys.Sub(dir)
 	}
-	return &subFS{fsys, dir}, nil
+	return wrapFS(&subFS{fsys, dir}, fsys, wrapAll), nil
 }
 
 type subFS struct {
@@ -87,6 +97,15 @@ func (f *subFS) Open(name string) (File, error) {
 	return file, f.fixErr(err)
 }
 
+func (f *subFS) Stat(name string) (FileInfo, error) {
+	full, err := f.fullName("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := Stat(f.fsys, full)
+	return info, f.fixErr(err)
+}
+
 func (f *subFS) ReadDir(name string) ([]DirEntry, error) {
 	full, err := f.fullName("read", name)
 	if err != nil {
@@ -114,7 +133,7 @@ func (f *subFS) Glob(pattern string) ([]string, error) {
 		return []string{"."}, nil
 	}
 
-	full := f.dir + "/" + pattern
+	full := escapeMeta(f.dir) + "/" + pattern
 	list, err := Glob(f.fsys, full)
 	for i, name := range list {
 		name, ok := f.shorten(name)
@@ -125,3 +144,120 @@ func (f *subFS) Glob(pattern string) ([]string, error) {
 	}
 	return list, f.fixErr(err)
 }
+
+func (f *subFS) RecursiveGlob(pattern string) ([]string, error) {
+	// Check pattern is well-formed.
+	for _, elem := range strings.Split(pattern, "/") {
+		if _, err := path.Match(elem, ""); err != nil {
+			return nil, err
+		}
+	}
+	if pattern == "." {
+		return []string{"."}, nil
+	}
+
+	full := escapeMeta(f.dir) + "/" + pattern
+	list, err := RecursiveGlob(f.fsys, full)
+	var out []string
+	for _, name := range list {
+		name, ok := f.shorten(name)
+		if !ok {
+			return nil, errors.New("invalid result from inner fsys RecursiveGlob: " + name + " not in " + f.dir)
+		}
+		if name != "." {
+			out = append(out, name)
+		}
+	}
+	return out, f.fixErr(err)
+}
+
+func (f *subFS) Sub(dir string) (FS, error) {
+	full, err := f.fullName("sub", dir)
+	if err != nil {
+		return nil, err
+	}
+	return Sub(f.fsys, full)
+}
+
+func (f *subFS) Lstat(name string) (FileInfo, error) {
+	full, err := f.fullName("lstat", name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := Lstat(f.fsys, full)
+	return info, f.fixErr(err)
+}
+
+func (f *subFS) ReadLink(name string) (string, error) {
+	full, err := f.fullName("readlink", name)
+	if err != nil {
+		return "", err
+	}
+	target, err := ReadLink(f.fsys, full)
+	return target, f.fixErr(err)
+}
+
+func (f *subFS) Create(name string, perm FileMode) (WritableFile, error) {
+	full, err := f.fullName("open", name)
+	if err != nil {
+		return nil, err
+	}
+	file, err := Create(f.fsys, full, perm)
+	return file, f.fixErr(err)
+}
+
+func (f *subFS) WriteFile(name string, data []byte, perm FileMode) error {
+	full, err := f.fullName("write", name)
+	if err != nil {
+		return err
+	}
+	return f.fixErr(WriteFile(f.fsys, full, data, perm))
+}
+
+func (f *subFS) Mkdir(name string, perm FileMode) error {
+	full, err := f.fullName("mkdir", name)
+	if err != nil {
+		return err
+	}
+	return f.fixErr(Mkdir(f.fsys, full, perm))
+}
+
+func (f *subFS) MkdirAll(name string, perm FileMode) error {
+	full, err := f.fullName("mkdir", name)
+	if err != nil {
+		return err
+	}
+	return f.fixErr(MkdirAll(f.fsys, full, perm))
+}
+
+func (f *subFS) Remove(name string) error {
+	full, err := f.fullName("remove", name)
+	if err != nil {
+		return err
+	}
+	return f.fixErr(Remove(f.fsys, full))
+}
+
+func (f *subFS) RemoveAll(name string) error {
+	if name == "." {
+		// Removing the root of the subtree would remove dir itself.
+		return &PathError{Op: "RemoveAll", Path: name, Err: ErrInvalid}
+	}
+	full, err := f.fullName("RemoveAll", name)
+	if err != nil {
+		return err
+	}
+	return f.fixErr(RemoveAll(f.fsys, full))
+}
+
+func (f *subFS) Rename(oldname, newname string) error {
+	oldfull, err := f.fullName("rename", oldname)
+	if err != nil {
+		return err
+	}
+	newfull, err := f.fullName("rename", newname)
+	if err != nil {
+		return err
+	}
+	return f.fixErr(Rename(f.fsys, oldfull, newfull))
+}
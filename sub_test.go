@@ -0,0 +1,145 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"testing"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+// openOnly hides all methods of an FS but Open.
+type openOnly struct{ fs.FS }
+
+// lstatOnly adds only the Lstat method to an FS.
+type lstatOnly struct{ fs.FS }
+
+func (f lstatOnly) Lstat(name string) (fs.FileInfo, error) { return fs.Lstat(f.FS, name) }
+
+// writeFileOnly adds only the WriteFile method to a MapFS.
+type writeFileOnly struct{ openOnly }
+
+func (f writeFileOnly) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	f.FS.(fstest.MapFS)[name] = &fstest.MapFile{Data: data, Mode: perm}
+	return nil
+}
+
+// interfaces reports which of the optional interfaces fsys implements.
+func interfaces(fsys fs.FS) map[string]bool {
+	_, stat := fsys.(fs.StatFS)
+	_, readDir := fsys.(fs.ReadDirFS)
+	_, readFile := fsys.(fs.ReadFileFS)
+	_, glob := fsys.(fs.GlobFS)
+	_, sub := fsys.(fs.SubFS)
+	_, lstat := fsys.(fs.LstatFS)
+	_, readLink := fsys.(fs.ReadLinkFS)
+	_, create := fsys.(fs.CreateFS)
+	_, writeFile := fsys.(fs.WriteFileFS)
+	_, mkdir := fsys.(fs.MkdirFS)
+	_, mkdirAll := fsys.(fs.MkdirAllFS)
+	_, remove := fsys.(fs.RemoveFS)
+	_, removeAll := fsys.(fs.RemoveAllFS)
+	_, rename := fsys.(fs.RenameFS)
+	return map[string]bool{
+		"Stat": stat, "ReadDir": readDir, "ReadFile": readFile, "Glob": glob, "Sub": sub,
+		"Lstat": lstat, "ReadLink": readLink,
+		"Create": create, "WriteFile": writeFile, "Mkdir": mkdir, "MkdirAll": mkdirAll,
+		"Remove": remove, "RemoveAll": removeAll, "Rename": rename,
+	}
+}
+
+func TestSubInterfaces(t *testing.T) {
+	dir := t.TempDir()
+	makeTree(t, dir, "a/b.txt")
+	readOnly := []string{"Stat", "ReadDir", "ReadFile", "Glob", "Sub"}
+	links := []string{"Lstat", "ReadLink"}
+	writes := []string{"Create", "WriteFile", "Mkdir", "MkdirAll", "Remove", "RemoveAll", "Rename"}
+	tests := []struct {
+		name string
+		fsys fs.FS
+		want [][]string
+	}{
+		{"MapFS", fstest.MapFS{"a/b.txt": {}}, [][]string{readOnly}},
+		{"Open only", openOnly{fstest.MapFS{"a/b.txt": {}}}, [][]string{readOnly}},
+		{"Lstat only", lstatOnly{openOnly{fstest.MapFS{"a/b.txt": {}}}}, [][]string{readOnly, {"Lstat"}}},
+		{"WriteFile only", writeFileOnly{openOnly{fstest.MapFS{"a/b.txt": {}}}}, [][]string{readOnly, {"WriteFile"}}},
+		{"DirFS", fs.DirFS(dir), [][]string{readOnly, links, writes}},
+	}
+	for _, tt := range tests {
+		sub, err := fs.Sub(tt.fsys, "a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := make(map[string]bool)
+		for _, list := range tt.want {
+			for _, name := range list {
+				want[name] = true
+			}
+		}
+		for name, ok := range interfaces(sub) {
+			if ok != want[name] {
+				t.Errorf("%s: Sub implements %sFS = %v, want %v", tt.name, name, ok, want[name])
+			}
+		}
+	}
+}
+
+func TestSubWriteFile(t *testing.T) {
+	m := fstest.MapFS{"a/b.txt": {}}
+	sub, err := fs.Sub(writeFileOnly{openOnly{m}}, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.WriteFile(sub, "c.txt", []byte("c"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	if f := m["a/c.txt"]; f == nil || string(f.Data) != "c" {
+		t.Errorf("WriteFile(c.txt) did not reach the WriteFile method of fsys")
+	}
+}
+
+func TestSubDirFS(t *testing.T) {
+	dir := t.TempDir()
+	makeTree(t, dir, "a/b/c.txt", "a/d.txt")
+	sub, err := fs.Sub(fs.DirFS(dir), "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fstest.TestFS(sub, "b/c.txt", "d.txt"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := fs.MkdirAll(sub, "new/dir", 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.WriteFile(sub, "new/dir/e.txt", []byte("e"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.Rename(sub, "new/dir/e.txt", "e.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.RemoveAll(sub, "new"); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.RemoveAll(sub, "."); err == nil {
+		t.Errorf("RemoveAll(.) succeeded, want error")
+	}
+	if err := fstest.TestFS(fs.DirFS(dir), "a/e.txt", "a/d.txt", "a/b/c.txt"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nested calls of Sub collapse into one.
+	sub2, err := fs.Sub(sub, "b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, err := fs.ReadFile(sub2, "c.txt"); string(data) != "a/b/c.txt" || err != nil {
+		t.Errorf("ReadFile(c.txt) = %q, %v, want %q, nil", data, err, "a/b/c.txt")
+	}
+	if _, err := fs.Stat(sub2, "d.txt"); err == nil {
+		t.Errorf("Stat(d.txt) in Sub(Sub(fsys, a), b) succeeded, want error")
+	}
+}
@@ -0,0 +1,551 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TarFS returns a file system (an FS) for the tar archive stored in r,
+// which holds size bytes. If the archive is compressed with gzip,
+// as for .tar.gz and .tgz files, it is decompressed transparently.
+//
+// TarFS reads the headers of the archive once to build an index of the
+// entries. For an uncompressed archive, the index records the offset of
+// the data of each file, so that files can be read with random access:
+// they implement io.ReaderAt and io.Seeker. A gzip-compressed archive
+// cannot be read at random, so opening a file decompresses the archive
+// sequentially from the start up to that file. Sparse files are read
+// the same way in both cases.
+//
+// Names in the archive are cleaned of a leading "./" and a trailing slash.
+// Entries whose names do not then satisfy ValidPath are omitted, and when
+// the archive contains several entries with the same name, the last one
+// is used, as when extracting the archive. Global PAX headers, which are
+// not files, are omitted too. Directories implied by the names of the
+// files they contain, but without entries of their own, are synthesized
+// with mode ModeDir|0555 and a zero modification time.
+//
+// The type of each entry is mapped onto its FileMode: symbolic links
+// have ModeSymlink, character and block devices ModeDevice (with
+// ModeCharDevice for the former) and FIFOs ModeNamedPipe. A hard link
+// is presented as a regular file with the content of its target.
+// Open, Stat and ReadDir follow symbolic links whose targets are relative
+// names within the archive; Lstat and ReadLink describe the links
+// themselves. The tar.Header of an entry is returned by the Sys method
+// of its FileInfo.
+//
+// The returned file system implements StatFS, LstatFS, ReadLinkFS,
+// ReadDirFS, ReadFileFS and SubFS. r must not be modified while the
+// file system is in use.
+func TarFS(r io.ReaderAt, size int64) (FS, error) {
+	t := &tarArchive{r: r, size: size}
+	var magic [2]byte
+	if n, _ := r.ReadAt(magic[:], 0); n == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		t.gzip = true
+	}
+	if err := t.scan(); err != nil {
+		return nil, err
+	}
+	return &tarFS{t, "."}, nil
+}
+
+// A tarArchive is the index of a tar archive.
+type tarArchive struct {
+	r     io.ReaderAt
+	size  int64
+	gzip  bool
+	index map[string]*tarEntry
+}
+
+// A tarEntry is a file or directory in a tar archive.
+// It implements DirEntry and FileInfo.
+type tarEntry struct {
+	name     string      // base name
+	hdr      *tar.Header // nil for synthesized directories
+	seq      int         // number of headers preceding this one in the archive
+	offset   int64       // offset of the data, or -1 if it must be read sequentially
+	data     *tarEntry   // entry holding the content: the entry itself or the target of a hard link
+	children []*tarEntry // directory contents, sorted by name
+}
+
+// reader returns a reader for the archive decompressed, if needed, from the start.
+func (t *tarArchive) reader() (io.Reader, io.Closer, error) {
+	var r io.Reader = io.NewSectionReader(t.r, 0, t.size)
+	if !t.gzip {
+		return r, nil, nil
+	}
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return zr, zr, nil
+}
+
+// scan reads the headers of the archive and builds its index.
+func (t *tarArchive) scan() error {
+	r, c, err := t.reader()
+	if err != nil {
+		return err
+	}
+	if c != nil {
+		defer c.Close()
+	}
+	seeker, _ := r.(io.Seeker)
+
+	t.index = map[string]*tarEntry{".": {name: ".", offset: -1}}
+	tr := tar.NewReader(r)
+	for seq := 0; ; seq++ {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag == tar.TypeXGlobalHeader {
+			// Global PAX headers, such as those written by git archive,
+			// are not files, but seq counts them for openFile.
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(hdr.Name, "./"), "/")
+		if !ValidPath(name) || name == "." {
+			continue
+		}
+		e := &tarEntry{name: path.Base(name), hdr: hdr, seq: seq, offset: -1}
+		if seeker != nil && !isSparse(hdr) {
+			if e.offset, err = seeker.Seek(0, io.SeekCurrent); err != nil {
+				return err
+			}
+		}
+		t.index[name] = e
+	}
+
+	// Link up directories and hard links.
+	names := make([]string, 0, len(t.index))
+	for name := range t.index {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if name == "." {
+			continue
+		}
+		e := t.index[name]
+		parent := t.parent(path.Dir(name))
+		if parent == nil {
+			delete(t.index, name) // a parent is not a directory
+			continue
+		}
+		parent.children = append(parent.children, e)
+	}
+	for _, e := range t.index {
+		sort.Slice(e.children, func(i, j int) bool { return e.children[i].name < e.children[j].name })
+		e.data = t.linkTarget(e)
+	}
+	return nil
+}
+
+// parent returns the entry for the directory dir,
+// synthesizing it and its parents as needed.
+// It returns nil if dir or one of its parents is not a directory.
+func (t *tarArchive) parent(dir string) *tarEntry {
+	if e, ok := t.index[dir]; ok {
+		if !e.IsDir() {
+			return nil
+		}
+		return e
+	}
+	parent := t.parent(path.Dir(dir))
+	if parent == nil {
+		return nil
+	}
+	e := &tarEntry{name: path.Base(dir), offset: -1}
+	t.index[dir] = e
+	parent.children = append(parent.children, e)
+	return e
+}
+
+// linkTarget returns the entry holding the content of e,
+// following hard links, or nil if e has no content.
+func (t *tarArchive) linkTarget(e *tarEntry) *tarEntry {
+	for i := 0; e != nil && e.hdr != nil && i < 255; i++ {
+		switch e.hdr.Typeflag {
+		case tar.TypeReg, tar.TypeRegA, tar.TypeCont, tar.TypeGNUSparse:
+			return e
+		case tar.TypeLink:
+			name := strings.TrimSuffix(strings.TrimPrefix(e.hdr.Linkname, "./"), "/")
+			e = t.index[name]
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// isSparse reports whether hdr describes a sparse file,
+// whose data is not stored contiguously in the archive.
+func isSparse(hdr *tar.Header) bool {
+	if hdr.Typeflag == tar.TypeGNUSparse {
+		return true
+	}
+	for k := range hdr.PAXRecords {
+		if strings.HasPrefix(k, "GNU.sparse.") {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *tarEntry) Name() string { return e.name }
+
+func (e *tarEntry) IsDir() bool {
+	return e.hdr == nil || e.hdr.Typeflag == tar.TypeDir
+}
+
+func (e *tarEntry) Size() int64 {
+	if e.data == nil {
+		return 0
+	}
+	return e.data.hdr.Size
+}
+
+func (e *tarEntry) Mode() FileMode {
+	if e.hdr == nil {
+		return ModeDir | 0555
+	}
+	return FileMode(e.hdr.FileInfo().Mode())
+}
+
+func (e *tarEntry) Type() FileMode { return e.Mode().Type() }
+
+func (e *tarEntry) ModTime() time.Time {
+	if e.hdr == nil {
+		return time.Time{}
+	}
+	return e.hdr.ModTime
+}
+
+func (e *tarEntry) Sys() interface{} {
+	if e.hdr == nil {
+		return nil
+	}
+	return e.hdr
+}
+
+func (e *tarEntry) Info() (FileInfo, error) { return e, nil }
+
+// named returns the FileInfo of e for the file named name, which leads
+// to e through symbolic links: e, but with the base name of name,
+// as Stat reports the name of a link rather than that of its target.
+func (e *tarEntry) named(name string) FileInfo {
+	if base := path.Base(name); name != "." && base != e.name {
+		return &namedFileInfo{e, base}
+	}
+	return e
+}
+
+// A tarFS is the file system returned by TarFS, or a subtree of it.
+type tarFS struct {
+	t   *tarArchive
+	dir string // root of the subtree
+}
+
+// lookup returns the entry for name, following symbolic links
+// in its directory elements, and in its final element if follow is true.
+func (f *tarFS) lookup(op, name string, follow bool) (*tarEntry, error) {
+	if !ValidPath(name) {
+		return nil, &PathError{Op: op, Path: name, Err: ErrInvalid}
+	}
+	full := name
+	if f.dir != "." {
+		full = path.Join(f.dir, name)
+	}
+	e, err := f.t.resolve(full, follow)
+	if err != nil {
+		return nil, &PathError{Op: op, Path: name, Err: err}
+	}
+	return e, nil
+}
+
+// resolve returns the entry named full, as described for tarFS.lookup.
+func (t *tarArchive) resolve(full string, follow bool) (*tarEntry, error) {
+	links := 0
+	cur := "."
+	rest := full
+	for rest != "" {
+		elem := rest
+		if i := strings.IndexByte(rest, '/'); i >= 0 {
+			elem, rest = rest[:i], rest[i+1:]
+		} else {
+			rest = ""
+		}
+		switch elem {
+		case "", ".":
+			continue
+		case "..":
+			if cur == "." {
+				return nil, ErrNotExist
+			}
+			cur = path.Dir(cur)
+			continue
+		}
+
+		next := path.Join(cur, elem)
+		e := t.index[next]
+		if e == nil {
+			return nil, ErrNotExist
+		}
+		if e.hdr != nil && e.hdr.Typeflag == tar.TypeSymlink && (rest != "" || follow) {
+			if links++; links > 255 {
+				return nil, ErrSymlinkLoop
+			}
+			target := e.hdr.Linkname
+			if target == "" || path.IsAbs(target) {
+				return nil, ErrNotExist
+			}
+			if rest != "" {
+				target += "/" + rest
+			}
+			rest = target
+			continue
+		}
+		if rest != "" && !e.IsDir() {
+			return nil, ErrNotExist
+		}
+		cur = next
+	}
+	return t.index[cur], nil
+}
+
+func (f *tarFS) Open(name string) (File, error) {
+	e, err := f.lookup("open", name, true)
+	if err != nil {
+		return nil, err
+	}
+	if e.IsDir() {
+		return &tarDir{e: e, path: name}, nil
+	}
+	return f.openFile("open", name, e)
+}
+
+// openFile opens the file e, which is not a directory.
+func (f *tarFS) openFile(op, name string, e *tarEntry) (File, error) {
+	d := e.data
+	if d == nil {
+		// No content: devices, FIFOs and dangling hard links.
+		return &tarFile{e, name, io.NewSectionReader(f.t.r, 0, 0)}, nil
+	}
+	if d.offset >= 0 {
+		return &tarFile{e, name, io.NewSectionReader(f.t.r, d.offset, d.hdr.Size)}, nil
+	}
+	r, c, err := f.t.reader()
+	if err != nil {
+		return nil, &PathError{Op: op, Path: name, Err: err}
+	}
+	tr := tar.NewReader(r)
+	for i := 0; i <= d.seq; i++ {
+		if _, err := tr.Next(); err != nil {
+			if c != nil {
+				c.Close()
+			}
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, &PathError{Op: op, Path: name, Err: err}
+		}
+	}
+	return &tarStreamFile{e, name, tr, c}, nil
+}
+
+func (f *tarFS) Stat(name string) (FileInfo, error) {
+	e, err := f.lookup("stat", name, true)
+	if err != nil {
+		return nil, err
+	}
+	return e.named(name), nil
+}
+
+func (f *tarFS) Lstat(name string) (FileInfo, error) {
+	e, err := f.lookup("lstat", name, false)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (f *tarFS) ReadLink(name string) (string, error) {
+	e, err := f.lookup("readlink", name, false)
+	if err != nil {
+		return "", err
+	}
+	if e.hdr == nil || e.hdr.Typeflag != tar.TypeSymlink {
+		return "", &PathError{Op: "readlink", Path: name, Err: ErrInvalid}
+	}
+	return e.hdr.Linkname, nil
+}
+
+func (f *tarFS) ReadDir(name string) ([]DirEntry, error) {
+	e, err := f.lookup("readdir", name, true)
+	if err != nil {
+		return nil, err
+	}
+	if !e.IsDir() {
+		return nil, &PathError{Op: "readdir", Path: name, Err: errors.New("not a directory")}
+	}
+	list := make([]DirEntry, len(e.children))
+	for i, c := range e.children {
+		list[i] = c
+	}
+	return list, nil
+}
+
+func (f *tarFS) ReadFile(name string) ([]byte, error) {
+	e, err := f.lookup("read", name, true)
+	if err != nil {
+		return nil, err
+	}
+	if e.IsDir() {
+		return nil, &PathError{Op: "read", Path: name, Err: errors.New("is a directory")}
+	}
+	file, err := f.openFile("read", name, e)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ioutil.ReadAll(file)
+}
+
+func (f *tarFS) Sub(dir string) (FS, error) {
+	if !ValidPath(dir) {
+		return nil, &PathError{Op: "sub", Path: dir, Err: errors.New("invalid name")}
+	}
+	if dir == "." {
+		return f, nil
+	}
+	return &tarFS{f.t, path.Join(f.dir, dir)}, nil
+}
+
+// A tarFile is a file in a tar archive that can be read at random.
+type tarFile struct {
+	e    *tarEntry
+	path string
+	sr   *io.SectionReader // nil once closed
+}
+
+func (f *tarFile) Stat() (FileInfo, error) { return f.e.named(f.path), nil }
+
+func (f *tarFile) Read(b []byte) (int, error) {
+	if f.sr == nil {
+		return 0, &PathError{Op: "read", Path: f.path, Err: ErrClosed}
+	}
+	n, err := f.sr.Read(b)
+	if err != nil && err != io.EOF {
+		err = &PathError{Op: "read", Path: f.path, Err: err}
+	}
+	return n, err
+}
+
+func (f *tarFile) ReadAt(b []byte, off int64) (int, error) {
+	if f.sr == nil {
+		return 0, &PathError{Op: "read", Path: f.path, Err: ErrClosed}
+	}
+	if off < 0 {
+		return 0, &PathError{Op: "read", Path: f.path, Err: ErrInvalid}
+	}
+	n, err := f.sr.ReadAt(b, off)
+	if err != nil && err != io.EOF {
+		err = &PathError{Op: "read", Path: f.path, Err: err}
+	}
+	return n, err
+}
+
+func (f *tarFile) Seek(offset int64, whence int) (int64, error) {
+	if f.sr == nil {
+		return 0, &PathError{Op: "seek", Path: f.path, Err: ErrClosed}
+	}
+	ret, err := f.sr.Seek(offset, whence)
+	if err != nil {
+		err = &PathError{Op: "seek", Path: f.path, Err: ErrInvalid}
+	}
+	return ret, err
+}
+
+func (f *tarFile) Close() error {
+	if f.sr == nil {
+		return &PathError{Op: "close", Path: f.path, Err: ErrClosed}
+	}
+	f.sr = nil
+	return nil
+}
+
+// A tarStreamFile is a file in a tar archive that must be read sequentially.
+type tarStreamFile struct {
+	e    *tarEntry
+	path string
+	r    io.Reader // nil once closed
+	c    io.Closer // closes the decompressor, if any
+}
+
+func (f *tarStreamFile) Stat() (FileInfo, error) { return f.e.named(f.path), nil }
+
+func (f *tarStreamFile) Read(b []byte) (int, error) {
+	if f.r == nil {
+		return 0, &PathError{Op: "read", Path: f.path, Err: ErrClosed}
+	}
+	n, err := f.r.Read(b)
+	if err != nil && err != io.EOF {
+		err = &PathError{Op: "read", Path: f.path, Err: err}
+	}
+	return n, err
+}
+
+func (f *tarStreamFile) Close() error {
+	if f.r == nil {
+		return &PathError{Op: "close", Path: f.path, Err: ErrClosed}
+	}
+	f.r = nil
+	if f.c != nil {
+		return f.c.Close()
+	}
+	return nil
+}
+
+// A tarDir is a directory in a tar archive.
+type tarDir struct {
+	e      *tarEntry
+	path   string
+	offset int
+}
+
+func (d *tarDir) Stat() (FileInfo, error) { return d.e.named(d.path), nil }
+func (d *tarDir) Close() error            { return nil }
+func (d *tarDir) Read(b []byte) (int, error) {
+	return 0, &PathError{Op: "read", Path: d.path, Err: errors.New("is a directory")}
+}
+
+func (d *tarDir) ReadDir(count int) ([]DirEntry, error) {
+	n := len(d.e.children) - d.offset
+	if n == 0 && count > 0 {
+		return nil, io.EOF
+	}
+	if count > 0 && n > count {
+		n = count
+	}
+	list := make([]DirEntry, n)
+	for i := range list {
+		list[i] = d.e.children[d.offset+i]
+	}
+	d.offset += n
+	return list, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+// makeTar returns a tar archive holding hdrs, with the content of each
+// regular file being its name, compressed with gzip if compress is set.
+func makeTar(t *testing.T, compress bool, hdrs ...*tar.Header) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	var zw *gzip.Writer
+	tw := tar.NewWriter(&buf)
+	if compress {
+		zw = gzip.NewWriter(&buf)
+		tw = tar.NewWriter(zw)
+	}
+	for _, hdr := range hdrs {
+		var body []byte
+		if hdr.Typeflag == tar.TypeReg {
+			body = []byte(hdr.Name)
+			hdr.Size = int64(len(body))
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if zw != nil {
+		if err := zw.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return buf.Bytes()
+}
+
+func tarHeaders() []*tar.Header {
+	mtime := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	return []*tar.Header{
+		{Typeflag: tar.TypeXGlobalHeader, Name: "pax_global_header", PAXRecords: map[string]string{"comment": "0123456789abcdef"}},
+		{Typeflag: tar.TypeDir, Name: "dir/", Mode: 0755, ModTime: mtime},
+		{Typeflag: tar.TypeReg, Name: "dir/a.txt", Mode: 0644, ModTime: mtime},
+		{Typeflag: tar.TypeReg, Name: "./b.txt", Mode: 0644, ModTime: mtime},
+		{Typeflag: tar.TypeReg, Name: "implied/deep/c.txt", Mode: 0600, ModTime: mtime},
+		{Typeflag: tar.TypeLink, Name: "hard.txt", Linkname: "b.txt", Mode: 0644, ModTime: mtime},
+		{Typeflag: tar.TypeSymlink, Name: "dir/up.link", Linkname: "../b.txt", Mode: 0777, ModTime: mtime},
+		{Typeflag: tar.TypeSymlink, Name: "dir.link", Linkname: "dir", Mode: 0777, ModTime: mtime},
+		{Typeflag: tar.TypeSymlink, Name: "dangling.link", Linkname: "missing", Mode: 0777, ModTime: mtime},
+		{Typeflag: tar.TypeReg, Name: "../escape.txt", Mode: 0644, ModTime: mtime},
+	}
+}
+
+func TestTarFS(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		data := makeTar(t, compress, tarHeaders()...)
+		fsys, err := fs.TarFS(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := fstest.TestFS(fsys, "dir", "dir/a.txt", "dir/up.link", "b.txt", "implied/deep/c.txt",
+			"hard.txt", "dir.link", "dangling.link"); err != nil {
+			t.Fatalf("compress=%v: %v", compress, err)
+		}
+
+		list, err := fs.ReadDir(fsys, ".")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, d := range list {
+			names = append(names, d.Name())
+		}
+		if want := "b.txt dangling.link dir dir.link hard.txt implied"; strings.Join(names, " ") != want {
+			t.Errorf("compress=%v: ReadDir(.) = %s, want %s", compress, strings.Join(names, " "), want)
+		}
+
+		for name, want := range map[string]string{
+			"dir/a.txt":   "dir/a.txt",
+			"hard.txt":    "./b.txt",
+			"dir/up.link": "./b.txt",
+		} {
+			if data, err := fs.ReadFile(fsys, name); string(data) != want || err != nil {
+				t.Errorf("compress=%v: ReadFile(%s) = %q, %v, want %q, nil", compress, name, data, err, want)
+			}
+		}
+		if target, err := fs.ReadLink(fsys, "dir.link"); target != "dir" || err != nil {
+			t.Errorf("compress=%v: ReadLink(dir.link) = %q, %v, want %q, nil", compress, target, err, "dir")
+		}
+
+		// Following a link keeps its name, as os.Stat does.
+		for _, name := range []string{"dir/up.link", "dir.link", "dir.link/up.link"} {
+			info, err := fs.Stat(fsys, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f, err := fsys.Open(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			finfo, err := f.Stat()
+			f.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if base := path.Base(name); info.Name() != base || finfo.Name() != base {
+				t.Errorf("compress=%v: Stat(%s).Name() = %q, file.Stat().Name() = %q, want %q",
+					compress, name, info.Name(), finfo.Name(), base)
+			}
+			if info.Mode()&fs.ModeSymlink != 0 {
+				t.Errorf("compress=%v: Stat(%s) reports a symbolic link", compress, name)
+			}
+		}
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"errors"
+	"io"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Whiteout markers hide files in the lower layers of a UnionFS.
+// A file named WhiteoutPrefix+name in a directory of a layer hides name
+// in the same directory of all lower layers, and a file named
+// WhiteoutOpaque in a directory of a layer hides the contents of that
+// directory in all lower layers. The markers themselves are never visible
+// through the union. Their content and type are irrelevant.
+const (
+	WhiteoutPrefix = ".wh."
+	WhiteoutOpaque = ".wh..wh..opq"
+)
+
+// UnionFS returns a file system (an FS) that overlays layers,
+// the first of which is the upper-most.
+//
+// A name refers to the file of that name in the upper-most layer that
+// contains it, unless a layer above hides it with a whiteout marker.
+// The contents of a directory are the union of the contents of the
+// directories of that name in the layers, with the entries of upper layers
+// shadowing entries of the same name in lower layers. A file that is not a
+// directory hides any directory of the same name in the layers below it.
+// The FileInfo of a directory, and its Read and Close methods, are those
+// of the upper-most directory.
+//
+// The returned file system implements StatFS, ReadDirFS and ReadFileFS.
+// Files returned by its Open method implement ReadDirFile for directories.
+func UnionFS(layers ...FS) FS {
+	return &unionFS{layers}
+}
+
+type unionFS struct {
+	layers []FS
+}
+
+// resolve returns the layers in which name is visible, upper-most first,
+// together with the FileInfo of name in the first of them.
+// If name is a directory, each of the returned layers contributes entries
+// to it; otherwise, only one layer is returned.
+func (u *unionFS) resolve(op, name string) ([]FS, FileInfo, error) {
+	if !ValidPath(name) {
+		return nil, nil, &PathError{Op: op, Path: name, Err: ErrInvalid}
+	}
+	layers, info, err := u.lookup(u.layers, ".", ".")
+	if err == nil && name != "." {
+		dir := "."
+		for _, elem := range strings.Split(name, "/") {
+			if !info.IsDir() || strings.HasPrefix(elem, WhiteoutPrefix) {
+				err = ErrNotExist
+				break
+			}
+			full := path.Join(dir, elem)
+			layers, info, err = u.lookup(layers, dir, full)
+			if err != nil {
+				break
+			}
+			dir = full
+		}
+	}
+	if err != nil {
+		return nil, nil, &PathError{Op: op, Path: name, Err: err}
+	}
+	return layers, info, nil
+}
+
+// lookup returns the layers among candidates, the layers contributing
+// to the directory dir, in which the file full in dir is visible,
+// together with the FileInfo of full in the first of them.
+func (u *unionFS) lookup(candidates []FS, dir, full string) ([]FS, FileInfo, error) {
+	var layers []FS
+	var info FileInfo
+	for _, l := range candidates {
+		i, err := Stat(l, full)
+		if err != nil {
+			if !errors.Is(err, ErrNotExist) {
+				if e, ok := err.(*PathError); ok {
+					err = e.Err
+				}
+				return nil, nil, err
+			}
+			if u.whiteout(l, dir, full) {
+				break
+			}
+			continue
+		}
+		if info == nil {
+			info = i
+		} else if !i.IsDir() {
+			break
+		}
+		layers = append(layers, l)
+		if !i.IsDir() || u.whiteout(l, dir, full) || exists(l, path.Join(full, WhiteoutOpaque)) {
+			break
+		}
+	}
+	if info == nil {
+		return nil, nil, ErrNotExist
+	}
+	return layers, info, nil
+}
+
+// whiteout reports whether the layer l has a whiteout marker
+// for the file full in the directory dir.
+func (u *unionFS) whiteout(l FS, dir, full string) bool {
+	return full != "." && exists(l, path.Join(dir, WhiteoutPrefix+path.Base(full)))
+}
+
+// exists reports whether the named file exists in fsys,
+// without following a final symbolic link.
+func exists(fsys FS, name string) bool {
+	_, err := Lstat(fsys, name)
+	return err == nil
+}
+
+func (u *unionFS) Open(name string) (File, error) {
+	layers, info, err := u.resolve("open", name)
+	if err != nil {
+		return nil, err
+	}
+	file, err := layers[0].Open(name)
+	if err != nil || !info.IsDir() {
+		return file, err
+	}
+	return &unionDir{File: file, u: u, layers: layers, name: name}, nil
+}
+
+func (u *unionFS) Stat(name string) (FileInfo, error) {
+	_, info, err := u.resolve("stat", name)
+	return info, err
+}
+
+func (u *unionFS) ReadDir(name string) ([]DirEntry, error) {
+	layers, info, err := u.resolve("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, &PathError{Op: "readdir", Path: name, Err: errors.New("not a directory")}
+	}
+	return u.readDir(layers, name)
+}
+
+// readDir returns the merged contents of the directory name in layers,
+// sorted by filename.
+func (u *unionFS) readDir(layers []FS, name string) ([]DirEntry, error) {
+	seen := make(map[string]bool)
+	var list []DirEntry
+	for _, l := range layers {
+		dir, err := ReadDir(l, name)
+		if err != nil {
+			return nil, err
+		}
+		var hidden []string
+		for _, d := range dir {
+			elem := d.Name()
+			if strings.HasPrefix(elem, WhiteoutPrefix) {
+				if elem != WhiteoutOpaque {
+					hidden = append(hidden, elem[len(WhiteoutPrefix):])
+				}
+				continue
+			}
+			if !seen[elem] {
+				seen[elem] = true
+				list = append(list, d)
+			}
+		}
+		// Whiteouts apply to lower layers only.
+		for _, elem := range hidden {
+			seen[elem] = true
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name() < list[j].Name() })
+	return list, nil
+}
+
+func (u *unionFS) ReadFile(name string) ([]byte, error) {
+	layers, _, err := u.resolve("read", name)
+	if err != nil {
+		return nil, err
+	}
+	return ReadFile(layers[0], name)
+}
+
+// A unionDir is a directory opened by a unionFS.
+// Its ReadDir method lists the merged contents of the directory.
+type unionDir struct {
+	File
+	u       *unionFS
+	layers  []FS
+	name    string
+	entries []DirEntry
+	read    bool
+	offset  int
+}
+
+func (d *unionDir) ReadDir(count int) ([]DirEntry, error) {
+	if !d.read {
+		entries, err := d.u.readDir(d.layers, d.name)
+		if err != nil {
+			return nil, err
+		}
+		d.entries = entries
+		d.read = true
+	}
+	n := len(d.entries) - d.offset
+	if n == 0 && count > 0 {
+		return nil, io.EOF
+	}
+	if count > 0 && n > count {
+		n = count
+	}
+	list := make([]DirEntry, n)
+	copy(list, d.entries[d.offset:])
+	d.offset += n
+	return list, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+func TestUnionFS(t *testing.T) {
+	upper := fstest.MapFS{
+		"a.txt":                  {Data: []byte("upper a")},
+		"dir/new.txt":            {Data: []byte("upper new")},
+		"dir/.wh.gone.txt":       {},
+		"opaque/.wh..wh..opq":    {},
+		"opaque/only.txt":        {Data: []byte("upper only")},
+		"shadow":                 {Data: []byte("file over dir")},
+		"upper/.wh.nothing.here": {},
+	}
+	lower := fstest.MapFS{
+		"a.txt":             {Data: []byte("lower a")},
+		"b.txt":             {Data: []byte("lower b")},
+		"dir/gone.txt":      {Data: []byte("lower gone")},
+		"dir/kept.txt":      {Data: []byte("lower kept")},
+		"opaque/hidden.txt": {Data: []byte("lower hidden")},
+		"shadow/x.txt":      {Data: []byte("lower x")},
+	}
+	fsys := fs.UnionFS(upper, lower)
+	if err := fstest.TestFS(fsys, "a.txt", "b.txt", "dir/new.txt", "dir/kept.txt", "opaque/only.txt", "shadow", "upper"); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, want := range map[string]string{
+		"a.txt":        "upper a",
+		"b.txt":        "lower b",
+		"dir/kept.txt": "lower kept",
+		"shadow":       "file over dir",
+	} {
+		if data, err := fs.ReadFile(fsys, name); string(data) != want || err != nil {
+			t.Errorf("ReadFile(%s) = %q, %v, want %q, nil", name, data, err, want)
+		}
+	}
+	for _, name := range []string{"dir/gone.txt", "opaque/hidden.txt", "shadow/x.txt", "dir/.wh.gone.txt", "opaque/.wh..wh..opq"} {
+		if _, err := fs.Stat(fsys, name); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Stat(%s) = %v, want ErrNotExist", name, err)
+		}
+	}
+	list, err := fs.ReadDir(fsys, "dir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, d := range list {
+		names = append(names, d.Name())
+	}
+	if got, want := strings.Join(names, " "), "kept.txt new.txt"; got != want {
+		t.Errorf("ReadDir(dir) = %s, want %s", got, want)
+	}
+}
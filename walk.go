@@ -14,6 +14,11 @@ import (
 // as an error by any function.
 var SkipDir = errors.New("skip this directory")
 
+// ErrSymlinkLoop is reported to a WalkDirFunc, wrapped in a *PathError,
+// when following a symbolic link would walk a directory that
+// is already being walked. See WalkDirOptions.FollowSymlinks.
+var ErrSymlinkLoop = errors.New("symbolic link loop")
+
 // WalkDirFunc is the type of the function called by WalkDir to visit
 // each each file or directory.
 //
@@ -109,10 +114,82 @@ func walkDir(fsys FS, name string, d DirEntry, walkDirFn WalkDirFunc) error {
 //
 // WalkDir does not follow symbolic links found in directories,
 // but if root itself is a symbolic link, its target will be walked.
+// Use WalkDirWithOptions to report such a root as the link itself.
 func WalkDir(fsys FS, root string, fn WalkDirFunc) error {
-	info, err := Stat(fsys, root)
+	return WalkDirWithOptions(fsys, root, nil, fn)
+}
+
+// WalkDirOptions holds optional settings for WalkDirWithOptions.
+// The zero value selects the behaviour of WalkDir.
+type WalkDirOptions struct {
+	// NoFollowRoot causes the root to be examined with Lstat instead of Stat.
+	// If root is a symbolic link, fn is then called once for the link itself
+	// and its target is not walked.
+	NoFollowRoot bool
+
+	// FollowSymlinks causes symbolic links found in directories to be followed.
+	// A link that can be resolved is reported to fn with a DirEntry
+	// describing its target under the link's name, and if the target
+	// is a directory, it is walked as if it were found in place of the link.
+	// Links that cannot be resolved are reported as the links themselves.
+	//
+	// If a link refers to a directory that is already being walked,
+	// that is, to the directory containing the link or to one of its parents,
+	// the link is not followed: instead fn is called once for the link
+	// with a *PathError wrapping ErrSymlinkLoop.
+	//
+	// Directories are identified by the device and inode numbers
+	// in FileInfo.Sys where the operating system provides them,
+	// and otherwise by their path with all symbolic links resolved
+	// using Lstat and ReadLink.
+	FollowSymlinks bool
+
+	// Unsorted causes each directory to be read in batches of BatchSize
+	// entries using the ReadDir method of the directory's ReadDirFile,
+	// and its entries to be visited in directory order as they are read,
+	// instead of reading and sorting the entire directory first.
+	// Memory use is then bounded by one batch for each directory
+	// on the path being visited, regardless of directory size,
+	// but the order of the walk is not deterministic.
+	//
+	// If opening a directory or reading a batch fails, fn is called
+	// a second time for the directory to report the error, as with WalkDir,
+	// and the entries of the directory not yet read are not visited.
+	Unsorted bool
+
+	// BatchSize is the number of entries read at a time when Unsorted is set.
+	// If BatchSize <= 0, a default size is used.
+	BatchSize int
+}
+
+// WalkDirWithOptions is like WalkDir but lets opts modify how the tree is walked.
+// A nil opts is equivalent to a pointer to a zero WalkDirOptions.
+func WalkDirWithOptions(fsys FS, root string, opts *WalkDirOptions, fn WalkDirFunc) error {
+	if opts == nil {
+		opts = &WalkDirOptions{}
+	}
+	var info FileInfo
+	var err error
+	if opts.NoFollowRoot {
+		info, err = Lstat(fsys, root)
+	} else {
+		info, err = Stat(fsys, root)
+	}
 	if err != nil {
 		err = fn(root, nil, err)
+	} else if opts.FollowSymlinks || opts.Unsorted {
+		w := &walker{fsys: fsys, fn: fn}
+		if opts.FollowSymlinks && info.Mode()&ModeSymlink == 0 {
+			w.follow = true
+			w.active = make(map[interface{}]bool)
+		}
+		if opts.Unsorted {
+			w.batch = opts.BatchSize
+			if w.batch <= 0 {
+				w.batch = defaultBatchSize
+			}
+		}
+		err = w.walk(root, &statDirEntry{info})
 	} else {
 		err = walkDir(fsys, root, &statDirEntry{info}, fn)
 	}
@@ -0,0 +1,157 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"io"
+	"path"
+)
+
+// defaultBatchSize is the number of directory entries read at a time
+// when WalkDirOptions.Unsorted is set and BatchSize is not.
+const defaultBatchSize = 256
+
+// A fileID identifies a file by the device and inode numbers
+// reported by the operating system.
+type fileID struct {
+	dev, ino uint64
+}
+
+// A walker walks a file tree as configured by WalkDirOptions.
+type walker struct {
+	fsys   FS
+	fn     WalkDirFunc
+	follow bool                 // follow symbolic links
+	batch  int                  // if > 0, stream directories in batches of this size
+	active map[interface{}]bool // identities of directories being walked, if following links
+}
+
+// walk recursively descends name, calling w.fn.
+// It behaves like walkDir, except for the changes selected by w.follow and w.batch.
+func (w *walker) walk(name string, d DirEntry) error {
+	var key interface{}
+	if w.follow {
+		if d.Type()&ModeSymlink != 0 {
+			if info, err := Stat(w.fsys, name); err == nil {
+				target := &followedDirEntry{d.Name(), info}
+				if !target.IsDir() {
+					d = target
+				} else if key = w.dirKey(name, target, true); key != nil {
+					if w.active[key] {
+						return w.fn(name, d, &PathError{Op: "walkdir", Path: name, Err: ErrSymlinkLoop})
+					}
+					d = target
+				}
+			}
+		} else if d.IsDir() {
+			key = w.dirKey(name, d, false)
+		}
+	}
+
+	if err := w.fn(name, d, nil); err != nil || !d.IsDir() {
+		if err == SkipDir && d.IsDir() {
+			// Successfully skipped directory.
+			err = nil
+		}
+		return err
+	}
+
+	if w.follow {
+		w.active[key] = true
+		defer delete(w.active, key)
+	}
+
+	if w.batch > 0 {
+		return w.stream(name, d)
+	}
+
+	dirs, err := ReadDir(w.fsys, name)
+	if err != nil {
+		// Second call, to report ReadDir error.
+		err = w.fn(name, d, err)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, d1 := range dirs {
+		name1 := path.Join(name, d1.Name())
+		if err := w.walk(name1, d1); err != nil {
+			if err == SkipDir {
+				break
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// stream visits the entries of the directory name in directory order,
+// reading them w.batch at a time, so that only one batch of the
+// directory is held in memory.
+func (w *walker) stream(name string, d DirEntry) error {
+	file, err := w.fsys.Open(name)
+	if err != nil {
+		// Second call, to report Open error.
+		return w.fn(name, d, err)
+	}
+	defer file.Close()
+
+	dir, ok := file.(ReadDirFile)
+	if !ok {
+		// Second call, to report the missing ReadDir.
+		return w.fn(name, d, &PathError{Op: "readdir", Path: name, Err: ErrNotImplemented})
+	}
+
+	for {
+		list, err := dir.ReadDir(w.batch)
+		for _, d1 := range list {
+			name1 := path.Join(name, d1.Name())
+			if err := w.walk(name1, d1); err != nil {
+				if err == SkipDir {
+					return nil
+				}
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			// Second call, to report ReadDir error.
+			return w.fn(name, d, err)
+		}
+	}
+}
+
+// dirKey returns a value identifying the directory name described by d.
+// If the directory cannot be identified, dirKey returns nil when
+// link is true, so that the link is not followed, and name otherwise.
+func (w *walker) dirKey(name string, d DirEntry, link bool) interface{} {
+	if info, err := d.Info(); err == nil {
+		if id, ok := sysFileID(info); ok {
+			return id
+		}
+	}
+	if real, err := evalSymlinks(w.fsys, name); err == nil {
+		return real
+	}
+	if link {
+		return nil
+	}
+	return name
+}
+
+// A followedDirEntry describes the target of a symbolic link
+// under the name of the link.
+type followedDirEntry struct {
+	name string
+	info FileInfo
+}
+
+func (d *followedDirEntry) Name() string            { return d.name }
+func (d *followedDirEntry) IsDir() bool             { return d.info.IsDir() }
+func (d *followedDirEntry) Type() FileMode          { return d.info.Mode().Type() }
+func (d *followedDirEntry) Info() (FileInfo, error) { return d.info, nil }
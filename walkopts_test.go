@@ -0,0 +1,209 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+// walkTypes walks root in fsys with opts and returns the names visited
+// by the WalkDirFunc, each followed by the type of its DirEntry and by
+// the error passed to the call, if any.
+func walkTypes(fsys fs.FS, root string, opts *fs.WalkDirOptions) ([]string, error) {
+	var calls []string
+	err := fs.WalkDirWithOptions(fsys, root, opts, func(name string, d fs.DirEntry, err error) error {
+		call := name
+		if d != nil {
+			call += " " + d.Type().String()
+		}
+		if err != nil {
+			if !errors.Is(err, fs.ErrSymlinkLoop) {
+				return err
+			}
+			call += ": loop"
+		}
+		calls = append(calls, call)
+		return nil
+	})
+	return calls, err
+}
+
+func TestWalkDirFollowSymlinks(t *testing.T) {
+	dir := t.TempDir()
+	makeTree(t, dir, "a/f.txt", "b/g.txt")
+	makeLinks(t, dir, map[string]string{
+		"a/b":           "..",
+		"a/g.link":      "../b/g.txt",
+		"b.link":        "b",
+		"dangling.link": "missing",
+	})
+	fsys := fs.DirFS(dir)
+	tests := []struct {
+		name string
+		root string
+		opts *fs.WalkDirOptions
+		want []string
+	}{
+		{
+			name: "not following",
+			root: ".",
+			opts: &fs.WalkDirOptions{},
+			want: []string{
+				". d---------", "a d---------", "a/b L---------", "a/f.txt ----------", "a/g.link L---------",
+				"b d---------", "b/g.txt ----------", "b.link L---------", "dangling.link L---------",
+			},
+		},
+		{
+			name: "following",
+			root: ".",
+			opts: &fs.WalkDirOptions{FollowSymlinks: true},
+			want: []string{
+				". d---------", "a d---------", "a/b L---------: loop", "a/f.txt ----------", "a/g.link ----------",
+				"b d---------", "b/g.txt ----------", "b.link d---------", "b.link/g.txt ----------",
+				"dangling.link L---------",
+			},
+		},
+		{
+			name: "following from a subdirectory",
+			root: "a",
+			opts: &fs.WalkDirOptions{FollowSymlinks: true},
+			want: []string{
+				"a d---------", "a/b d---------", "a/b/a d---------", "a/b/a/b L---------: loop",
+				"a/b/a/f.txt ----------", "a/b/a/g.link ----------", "a/b/b d---------", "a/b/b/g.txt ----------",
+				"a/b/b.link d---------", "a/b/b.link/g.txt ----------", "a/b/dangling.link L---------",
+				"a/f.txt ----------", "a/g.link ----------",
+			},
+		},
+		{
+			name: "root link",
+			root: "b.link",
+			opts: &fs.WalkDirOptions{},
+			want: []string{"b.link d---------", "b.link/g.txt ----------"},
+		},
+		{
+			name: "root link not followed",
+			root: "b.link",
+			opts: &fs.WalkDirOptions{NoFollowRoot: true},
+			want: []string{"b.link L---------"},
+		},
+		{
+			name: "root link not followed when following links",
+			root: "b.link",
+			opts: &fs.WalkDirOptions{NoFollowRoot: true, FollowSymlinks: true},
+			want: []string{"b.link L---------"},
+		},
+		{
+			name: "root directory not followed",
+			root: "b",
+			opts: &fs.WalkDirOptions{NoFollowRoot: true},
+			want: []string{"b d---------", "b/g.txt ----------"},
+		},
+	}
+	for _, tt := range tests {
+		calls, err := walkTypes(fsys, tt.root, tt.opts)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(calls, tt.want) {
+			t.Errorf("%s: calls = %q, want %q", tt.name, calls, tt.want)
+		}
+	}
+}
+
+// batchFS records the counts passed to the ReadDir methods
+// of the directories it opens.
+type batchFS struct {
+	fs.FS
+	counts []int
+}
+
+func (f *batchFS) Open(name string) (fs.File, error) {
+	file, err := f.FS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	if dir, ok := file.(fs.ReadDirFile); ok {
+		return &batchDir{dir, f}, nil
+	}
+	return file, nil
+}
+
+type batchDir struct {
+	fs.ReadDirFile
+	fsys *batchFS
+}
+
+func (d *batchDir) ReadDir(count int) ([]fs.DirEntry, error) {
+	d.fsys.counts = append(d.fsys.counts, count)
+	return d.ReadDirFile.ReadDir(count)
+}
+
+func TestWalkDirUnsorted(t *testing.T) {
+	m := fstest.MapFS{"z/last": {}}
+	for i := 0; i < 10; i++ {
+		m["big/"+strconv.Itoa(i)] = &fstest.MapFile{}
+	}
+	skip := map[string]bool{"big/4": true}
+	tests := []struct {
+		name string
+		skip bool
+		want int // number of calls
+	}{
+		{"all", false, 14},
+		{"skip in batch", true, 9}, // ., big, big/0 to big/4, z and z/last
+	}
+	for _, tt := range tests {
+		fsys := &batchFS{FS: m}
+		visits := make(map[string]int)
+		n := 0
+		err := fs.WalkDirWithOptions(fsys, ".", &fs.WalkDirOptions{Unsorted: true, BatchSize: 3},
+			func(name string, d fs.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+				n++
+				visits[name]++
+				if tt.skip && skip[name] {
+					return fs.SkipDir
+				}
+				return nil
+			})
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if n != tt.want {
+			t.Errorf("%s: %d calls, want %d", tt.name, n, tt.want)
+		}
+		for name, count := range visits {
+			if count != 1 {
+				t.Errorf("%s: %s visited %d times, want once", tt.name, name, count)
+			}
+		}
+		for _, name := range []string{".", "big", "z", "z/last"} {
+			if visits[name] != 1 {
+				t.Errorf("%s: %s not visited", tt.name, name)
+			}
+		}
+		if tt.skip {
+			for i := 5; i < 10; i++ {
+				if name := "big/" + strconv.Itoa(i); visits[name] != 0 {
+					t.Errorf("%s: %s visited after SkipDir", tt.name, name)
+				}
+			}
+		}
+		for _, count := range fsys.counts {
+			if count != 3 {
+				t.Errorf("%s: ReadDir(%d), want batches of 3", tt.name, count)
+			}
+		}
+	}
+}
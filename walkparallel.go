@@ -0,0 +1,204 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"path"
+	"runtime"
+	"sync"
+)
+
+// ParallelWalkDir walks the file tree rooted at root like WalkDir,
+// but reads directories concurrently using up to workers goroutines.
+// If workers <= 0, ParallelWalkDir uses runtime.GOMAXPROCS(0) workers.
+// This helps with file systems where each ReadDir has a high latency,
+// such as network or archive backed ones.
+//
+// Only directory reads are concurrent: fn is called from the goroutine
+// that called ParallelWalkDir, one call at a time, for the same paths,
+// with the same arguments and in the same lexical order as WalkDir would.
+// fn therefore need not be safe for concurrent use.
+//
+// To keep the workers busy, the subdirectories of a directory are read
+// ahead of the calls to fn that visit them. A directory for which fn
+// returns SkipDir may therefore already have been read; the result is
+// discarded. The listings held in memory are those of the directories
+// on the path being visited and of their subdirectories not yet visited.
+//
+// When fn returns an error other than SkipDir, ParallelWalkDir starts no
+// further directory reads, waits for the reads in progress to finish,
+// and returns the error.
+func ParallelWalkDir(fsys FS, root string, workers int, fn WalkDirFunc) error {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	info, err := Stat(fsys, root)
+	if err != nil {
+		err = fn(root, nil, err)
+	} else {
+		w := &parallelWalker{fsys: fsys, fn: fn, workers: workers}
+		err = w.walk(root, &statDirEntry{info}, w.newRead(root))
+		w.stop()
+	}
+	if err == SkipDir {
+		return nil
+	}
+	return err
+}
+
+// A dirRead is a directory read scheduled by a parallelWalker.
+type dirRead struct {
+	name    string
+	started bool          // read claimed by a worker or the walker; guarded by parallelWalker.mu
+	done    chan struct{} // closed when list and err are set
+	list    []DirEntry
+	err     error
+}
+
+// A parallelWalker holds the state of a ParallelWalkDir call.
+type parallelWalker struct {
+	fsys    FS
+	fn      WalkDirFunc
+	workers int
+
+	mu      sync.Mutex
+	stack   []*dirRead // pending reads; the next one to start is last
+	running int        // number of worker goroutines
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+// newRead returns a dirRead for name that has not been scheduled.
+func (w *parallelWalker) newRead(name string) *dirRead {
+	return &dirRead{name: name, done: make(chan struct{})}
+}
+
+// schedule queues reads so that reads[0] is started first,
+// starting worker goroutines as needed.
+func (w *parallelWalker) schedule(reads []*dirRead) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.stopped {
+		return
+	}
+	for i := len(reads) - 1; i >= 0; i-- {
+		if reads[i] != nil {
+			w.stack = append(w.stack, reads[i])
+		}
+	}
+	for w.running < w.workers && w.running < len(w.stack) {
+		w.running++
+		w.wg.Add(1)
+		go w.work()
+	}
+}
+
+// work performs queued reads until there are none left.
+func (w *parallelWalker) work() {
+	defer w.wg.Done()
+	for {
+		w.mu.Lock()
+		var r *dirRead
+		for len(w.stack) > 0 && r == nil {
+			r = w.stack[len(w.stack)-1]
+			w.stack = w.stack[:len(w.stack)-1]
+			if r.started {
+				r = nil
+			}
+		}
+		if r == nil {
+			w.running--
+			w.mu.Unlock()
+			return
+		}
+		r.started = true
+		w.mu.Unlock()
+		w.read(r)
+	}
+}
+
+// read performs r and marks it done.
+func (w *parallelWalker) read(r *dirRead) {
+	r.list, r.err = ReadDir(w.fsys, r.name)
+	close(r.done)
+}
+
+// wait returns the result of r, performing the read
+// in the calling goroutine if no worker has started it yet.
+func (w *parallelWalker) wait(r *dirRead) ([]DirEntry, error) {
+	w.mu.Lock()
+	claim := !r.started
+	r.started = true
+	w.mu.Unlock()
+	if claim {
+		w.read(r)
+	}
+	<-r.done
+	return r.list, r.err
+}
+
+// discard prevents reads that have not started from being performed.
+func (w *parallelWalker) discard(reads []*dirRead) {
+	w.mu.Lock()
+	for _, r := range reads {
+		if r != nil {
+			r.started = true
+		}
+	}
+	w.mu.Unlock()
+}
+
+// stop discards all pending reads and waits for running reads to finish.
+func (w *parallelWalker) stop() {
+	w.mu.Lock()
+	w.stopped = true
+	w.stack = nil
+	w.mu.Unlock()
+	w.wg.Wait()
+}
+
+// walk recursively descends name, calling w.fn.
+// If d is a directory, r is the read of its contents.
+func (w *parallelWalker) walk(name string, d DirEntry, r *dirRead) error {
+	if err := w.fn(name, d, nil); err != nil || !d.IsDir() {
+		if err == SkipDir && d.IsDir() {
+			// Successfully skipped directory.
+			err = nil
+		}
+		return err
+	}
+
+	dirs, err := w.wait(r)
+	if err != nil {
+		// Second call, to report ReadDir error.
+		err = w.fn(name, d, err)
+		if err != nil {
+			return err
+		}
+	}
+
+	reads := make([]*dirRead, len(dirs))
+	for i, d1 := range dirs {
+		if d1.IsDir() {
+			reads[i] = w.newRead(path.Join(name, d1.Name()))
+		}
+	}
+	w.schedule(reads)
+
+	for i, d1 := range dirs {
+		name1 := path.Join(name, d1.Name())
+		if err := w.walk(name1, d1, reads[i]); err != nil {
+			w.discard(reads[i+1:])
+			if err == SkipDir {
+				break
+			}
+			return err
+		}
+		if d1.IsDir() {
+			w.discard(reads[i : i+1]) // in case fn skipped it
+		}
+	}
+	return nil
+}
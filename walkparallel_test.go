@@ -0,0 +1,180 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+var walkTree = fstest.MapFS{
+	"a":         {Data: []byte("a")},
+	"b/c":       {Data: []byte("c")},
+	"b/d/e":     {Data: []byte("e")},
+	"b/d/f/g":   {Data: []byte("g")},
+	"b/h":       {Data: []byte("h")},
+	"i/j/k":     {Data: []byte("k")},
+	"i/l":       {Data: []byte("l")},
+	"m/n/o/p/q": {Data: []byte("q")},
+	"r":         {Data: []byte("r")},
+}
+
+// slowFS delays each ReadDir, records the number of concurrent calls,
+// and fails the ReadDir of the names in fail.
+type slowFS struct {
+	fs.FS
+	delay time.Duration
+	fail  map[string]bool
+
+	mu        sync.Mutex
+	active    int
+	maxActive int
+}
+
+var errReadDir = errors.New("read failed")
+
+func (f *slowFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	f.mu.Lock()
+	f.active++
+	if f.active > f.maxActive {
+		f.maxActive = f.active
+	}
+	f.mu.Unlock()
+	defer func() {
+		f.mu.Lock()
+		f.active--
+		f.mu.Unlock()
+	}()
+	time.Sleep(f.delay)
+	if f.fail[name] {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: errReadDir}
+	}
+	return fs.ReadDir(f.FS, name)
+}
+
+// walkCalls returns the calls of a WalkDirFunc made by walk,
+// formatted as strings, and the error returned by walk.
+// The WalkDirFunc returns the result of action for each call.
+func walkCalls(walk func(fn fs.WalkDirFunc) error, action func(name string, err error) error) ([]string, error) {
+	var calls []string
+	err := walk(func(name string, d fs.DirEntry, err error) error {
+		call := name
+		if err != nil {
+			call += ": " + err.Error()
+		}
+		calls = append(calls, call)
+		return action(name, err)
+	})
+	return calls, err
+}
+
+func TestParallelWalkDir(t *testing.T) {
+	errStop := errors.New("stop")
+	tests := []struct {
+		name   string
+		root   string
+		fail   map[string]bool
+		action func(name string, err error) error
+	}{
+		{
+			name:   "all",
+			root:   ".",
+			action: func(string, error) error { return nil },
+		},
+		{
+			name:   "subtree",
+			root:   "b",
+			action: func(string, error) error { return nil },
+		},
+		{
+			name: "skip dir",
+			root: ".",
+			action: func(name string, err error) error {
+				if name == "b/d" || name == "m" {
+					return fs.SkipDir
+				}
+				return nil
+			},
+		},
+		{
+			name: "skip rest of parent",
+			root: ".",
+			action: func(name string, err error) error {
+				if name == "b/d/e" {
+					return fs.SkipDir
+				}
+				return nil
+			},
+		},
+		{
+			name: "skip root",
+			root: ".",
+			action: func(name string, err error) error {
+				if name == "." {
+					return fs.SkipDir
+				}
+				return nil
+			},
+		},
+		{
+			name: "stop",
+			root: ".",
+			action: func(name string, err error) error {
+				if name == "b/d/f" {
+					return errStop
+				}
+				return nil
+			},
+		},
+		{
+			name:   "read errors",
+			root:   ".",
+			fail:   map[string]bool{"b/d": true, "i/j": true},
+			action: func(string, error) error { return nil },
+		},
+		{
+			name: "stop on read error",
+			root: ".",
+			fail: map[string]bool{"i": true},
+			action: func(name string, err error) error {
+				return err
+			},
+		},
+		{
+			name:   "missing root",
+			root:   "nonexistent",
+			action: func(name string, err error) error { return err },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, wantErr := walkCalls(func(fn fs.WalkDirFunc) error {
+				return fs.WalkDir(&slowFS{FS: walkTree, fail: tt.fail}, tt.root, fn)
+			}, tt.action)
+			for _, workers := range []int{0, 1, 2, 8} {
+				fsys := &slowFS{FS: walkTree, delay: time.Millisecond, fail: tt.fail}
+				got, err := walkCalls(func(fn fs.WalkDirFunc) error {
+					return fs.ParallelWalkDir(fsys, tt.root, workers, fn)
+				}, tt.action)
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("workers=%d: calls:\n%q\nwant:\n%q", workers, got, want)
+				}
+				if !errors.Is(err, wantErr) && (err == nil || wantErr == nil || err.Error() != wantErr.Error()) {
+					t.Errorf("workers=%d: error = %v, want %v", workers, err, wantErr)
+				}
+				if workers > 0 && fsys.maxActive > workers+1 {
+					// The walking goroutine may perform one read itself.
+					t.Errorf("workers=%d: %d concurrent reads", workers, fsys.maxActive)
+				}
+			}
+		})
+	}
+}
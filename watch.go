@@ -0,0 +1,234 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A WatchOp describes a change reported by Watch.
+type WatchOp uint32
+
+const (
+	WatchCreate WatchOp = iota + 1 // a file was created
+	WatchModify                    // the size, modification time or mode of a file changed
+	WatchRemove                    // a file was removed
+	WatchRename                    // a file was probably renamed
+)
+
+func (op WatchOp) String() string {
+	switch op {
+	case WatchCreate:
+		return "create"
+	case WatchModify:
+		return "modify"
+	case WatchRemove:
+		return "remove"
+	case WatchRename:
+		return "rename"
+	}
+	return "WatchOp(" + strconv.Itoa(int(op)) + ")"
+}
+
+// A WatchEvent is a change to a file reported by Watch.
+type WatchEvent struct {
+	Op      WatchOp
+	Name    string   // name of the file, as passed to a WalkDirFunc
+	OldName string   // for WatchRename, the name the file had before
+	Info    FileInfo // the FileInfo of the file; for WatchRemove, as last seen
+}
+
+// Watch reports changes to the file tree rooted at root in fsys.
+// It walks the tree with WalkDir when it is called, and again every
+// interval until ctx is done, sending a WatchEvent on the returned
+// channel for every change found by comparing the names, sizes,
+// modification times and modes of the files in successive walks.
+// Only the modes of directories are compared. The channel is closed
+// when ctx is done.
+//
+// A file removed and another created in the same interval are reported
+// as a single WatchRename if they are the only ones removed and created
+// with the same size, modification time and mode; the guess may be wrong.
+// The events of each walk are sent in the lexical order of their names.
+// Because changes are only seen by polling, several changes to a file
+// within an interval may be reported as one, or not at all.
+//
+// Directories that cannot be read are assumed to be unchanged,
+// and files that cannot be walked are reported as removed.
+// The root itself is not reported. Watch returns an error if interval
+// is not positive or the first walk of root fails.
+func Watch(ctx context.Context, fsys FS, root string, interval time.Duration) (<-chan WatchEvent, error) {
+	if interval <= 0 {
+		return nil, errors.New("fs: non-positive interval for Watch")
+	}
+	w := &watcher{fsys: fsys, root: root}
+	snap, err := w.snapshot(nil)
+	if err != nil {
+		return nil, err
+	}
+	c := make(chan WatchEvent)
+	go func() {
+		defer close(c)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			next, err := w.snapshot(snap)
+			if err != nil {
+				continue
+			}
+			for _, ev := range diffSnapshots(snap, next) {
+				select {
+				case c <- ev:
+				case <-ctx.Done():
+					return
+				}
+			}
+			snap = next
+		}
+	}()
+	return c, nil
+}
+
+type watcher struct {
+	fsys FS
+	root string
+}
+
+// A watchState is the state of a file seen by a walk.
+// The fields are copied from info when it is obtained,
+// because a FileInfo may describe the current state of a file.
+type watchState struct {
+	info    FileInfo
+	size    int64
+	modTime time.Time
+	mode    FileMode
+}
+
+// snapshot walks the tree and returns the state of every file in it
+// by name. Where the walk fails, the files of the previous snapshot prev
+// are carried over. If root cannot be walked for any reason but its
+// absence, snapshot returns the error.
+func (w *watcher) snapshot(prev map[string]watchState) (map[string]watchState, error) {
+	snap := make(map[string]watchState)
+	var failed []string // directories that could not be read
+	err := WalkDir(w.fsys, w.root, func(name string, d DirEntry, err error) error {
+		if err != nil {
+			if d == nil {
+				return err
+			}
+			failed = append(failed, name)
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if old, ok := prev[name]; ok {
+				snap[name] = old
+			}
+			return nil
+		}
+		if name != w.root || !info.IsDir() {
+			snap[name] = watchState{info, info.Size(), info.ModTime(), info.Mode()}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrNotExist) && prev != nil {
+			return snap, nil
+		}
+		return nil, err
+	}
+	for _, dir := range failed {
+		for name, st := range prev {
+			if dir == w.root || strings.HasPrefix(name, dir+"/") {
+				snap[name] = st
+			}
+		}
+	}
+	return snap, nil
+}
+
+// diffSnapshots returns the events turning the snapshot old into new,
+// sorted by name.
+func diffSnapshots(old, new map[string]watchState) []WatchEvent {
+	var events []WatchEvent
+	var created, removed []string
+	for name, st := range new {
+		oldSt, ok := old[name]
+		switch {
+		case !ok:
+			created = append(created, name)
+		case changed(oldSt, st):
+			events = append(events, WatchEvent{Op: WatchModify, Name: name, Info: st.info})
+		}
+	}
+	for name := range old {
+		if _, ok := new[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	// Pair up removed and created files that match only each other.
+	matches := func(names []string, snap map[string]watchState, st watchState) (n int, match string) {
+		for _, name := range names {
+			if sameFile(snap[name], st) {
+				n, match = n+1, name
+			}
+		}
+		return n, match
+	}
+	paired := make(map[string]bool)
+	for _, name := range created {
+		n, oldName := matches(removed, old, new[name])
+		if n != 1 {
+			continue
+		}
+		if n, _ := matches(created, new, old[oldName]); n != 1 {
+			continue
+		}
+		paired[oldName] = true
+		paired[name] = true
+		events = append(events, WatchEvent{Op: WatchRename, Name: name, OldName: oldName, Info: new[name].info})
+	}
+	for _, name := range created {
+		if !paired[name] {
+			events = append(events, WatchEvent{Op: WatchCreate, Name: name, Info: new[name].info})
+		}
+	}
+	for _, name := range removed {
+		if !paired[name] {
+			events = append(events, WatchEvent{Op: WatchRemove, Name: name, Info: old[name].info})
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].Name < events[j].Name })
+	return events
+}
+
+// changed reports whether a file in the state old at the last walk
+// and in the state new at this walk has changed.
+func changed(old, new watchState) bool {
+	if old.mode != new.mode {
+		return true
+	}
+	if new.mode.IsDir() {
+		return false
+	}
+	return old.size != new.size || !old.modTime.Equal(new.modTime)
+}
+
+// sameFile reports whether a and b are the states
+// of files that could be the same file under different names.
+func sameFile(a, b watchState) bool {
+	return a.mode == b.mode && a.size == b.size && a.modTime.Equal(b.modTime)
+}
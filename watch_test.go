@@ -0,0 +1,206 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+func TestWatchOpString(t *testing.T) {
+	for op, want := range map[fs.WatchOp]string{
+		fs.WatchCreate: "create",
+		fs.WatchRename: "rename",
+		0:              "WatchOp(0)",
+		42:             "WatchOp(42)",
+	} {
+		if got := op.String(); got != want {
+			t.Errorf("WatchOp(%d).String() = %q, want %q", uint32(op), got, want)
+		}
+	}
+}
+
+// A syncMapFS is a MapFS that can be changed while Watch walks it.
+type syncMapFS struct {
+	mu sync.Mutex
+	m  fstest.MapFS
+}
+
+func (f *syncMapFS) Open(name string) (fs.File, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.m.Open(name)
+}
+
+func (f *syncMapFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.m.ReadDir(name)
+}
+
+func (f *syncMapFS) ReadFile(name string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.m.ReadFile(name)
+}
+
+// change calls fn to change the map, between two walks.
+func (f *syncMapFS) change(fn func(m fstest.MapFS)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fn(f.m)
+}
+
+// watchEvents receives n events from c, formatted as "op name" or,
+// for renames, "rename old -> new".
+func watchEvents(t *testing.T, c <-chan fs.WatchEvent, n int) []string {
+	t.Helper()
+	var list []string
+	for len(list) < n {
+		select {
+		case ev, ok := <-c:
+			if !ok {
+				t.Fatalf("channel closed after events %q", list)
+			}
+			s := ev.Op.String() + " " + ev.Name
+			if ev.Op == fs.WatchRename {
+				s = "rename " + ev.OldName + " -> " + ev.Name
+			}
+			if ev.Info == nil {
+				t.Errorf("%s: nil Info", s)
+			}
+			list = append(list, s)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timeout after events %q, want %d", list, n)
+		}
+	}
+	return list
+}
+
+func TestWatch(t *testing.T) {
+	t1 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+	fsys := &syncMapFS{m: fstest.MapFS{
+		"a.txt":     {Data: []byte("a"), ModTime: t1},
+		"dir/b.txt": {Data: []byte("b"), ModTime: t1},
+	}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, err := fs.Watch(ctx, fsys, ".", time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []struct {
+		name   string
+		change func(m fstest.MapFS)
+		want   []string
+	}{
+		{"create", func(m fstest.MapFS) {
+			m["c.txt"] = &fstest.MapFile{Data: []byte("cc"), ModTime: t1}
+		}, []string{"create c.txt"}},
+		{"modify", func(m fstest.MapFS) {
+			m["a.txt"] = &fstest.MapFile{Data: []byte("a2"), ModTime: t2}
+		}, []string{"modify a.txt"}},
+		{"touch", func(m fstest.MapFS) {
+			m["a.txt"] = &fstest.MapFile{Data: []byte("a2"), ModTime: t1}
+		}, []string{"modify a.txt"}},
+		{"remove", func(m fstest.MapFS) {
+			delete(m, "c.txt")
+		}, []string{"remove c.txt"}},
+		{"rename", func(m fstest.MapFS) {
+			m["dir/d.txt"] = m["dir/b.txt"]
+			delete(m, "dir/b.txt")
+		}, []string{"rename dir/b.txt -> dir/d.txt"}},
+		{"ambiguous rename", func(m fstest.MapFS) {
+			// Two files with the same state: no rename can be guessed.
+			m["e1"] = &fstest.MapFile{Data: []byte("x"), ModTime: t2}
+			m["e2"] = &fstest.MapFile{Data: []byte("x"), ModTime: t2}
+		}, []string{"create e1", "create e2"}},
+		{"ambiguous rename back", func(m fstest.MapFS) {
+			m["f1"], m["f2"] = m["e1"], m["e2"]
+			delete(m, "e1")
+			delete(m, "e2")
+		}, []string{"remove e1", "remove e2", "create f1", "create f2"}},
+		{"new directory", func(m fstest.MapFS) {
+			m["new/g.txt"] = &fstest.MapFile{Data: []byte("g"), ModTime: t2}
+		}, []string{"create new", "create new/g.txt"}},
+		{"directory mode", func(m fstest.MapFS) {
+			m["new"] = &fstest.MapFile{Mode: fs.ModeDir | 0700}
+		}, []string{"modify new"}},
+		{"remove directory", func(m fstest.MapFS) {
+			delete(m, "new")
+			delete(m, "new/g.txt")
+		}, []string{"remove new", "remove new/g.txt"}},
+	}
+	for _, step := range steps {
+		fsys.change(step.change)
+		got := watchEvents(t, c, len(step.want))
+		// Events of one walk are sorted by name.
+		if !reflect.DeepEqual(got, sortedByName(step.want)) {
+			t.Errorf("%s: events %q, want %q", step.name, got, sortedByName(step.want))
+		}
+	}
+
+	cancel()
+	select {
+	case ev, ok := <-c:
+		if ok {
+			t.Errorf("event %v after cancel, want channel closed", ev)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("channel not closed after cancel")
+	}
+}
+
+// sortedByName sorts events formatted by watchEvents by file name.
+func sortedByName(events []string) []string {
+	name := func(s string) string { return s[strings.LastIndex(s, " ")+1:] }
+	list := append([]string(nil), events...)
+	sort.Slice(list, func(i, j int) bool { return name(list[i]) < name(list[j]) })
+	return list
+}
+
+func TestWatchReload(t *testing.T) {
+	// A template is reloaded from a MapFS whenever it changes.
+	fsys := &syncMapFS{m: fstest.MapFS{
+		"tmpl/page.html": {Data: []byte("v1"), ModTime: time.Unix(1, 0)},
+	}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, err := fs.Watch(ctx, fsys, "tmpl", time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys.change(func(m fstest.MapFS) {
+		m["tmpl/page.html"] = &fstest.MapFile{Data: []byte("v2"), ModTime: time.Unix(2, 0)}
+		m["other.txt"] = &fstest.MapFile{}
+	})
+	if got := watchEvents(t, c, 1); got[0] != "modify tmpl/page.html" {
+		t.Fatalf("events %q, want [modify tmpl/page.html]", got)
+	}
+	if data, err := fs.ReadFile(fsys, "tmpl/page.html"); string(data) != "v2" || err != nil {
+		t.Errorf("reloaded %q, %v, want %q, nil", data, err, "v2")
+	}
+}
+
+func TestWatchErrors(t *testing.T) {
+	ctx := context.Background()
+	if _, err := fs.Watch(ctx, fstest.MapFS{}, ".", 0); err == nil {
+		t.Errorf("Watch with zero interval succeeded, want error")
+	}
+	if _, err := fs.Watch(ctx, fstest.MapFS{}, "missing", time.Second); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Watch(missing) = %v, want ErrNotExist", err)
+	}
+}
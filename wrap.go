@@ -0,0 +1,198 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:generate go run mkwrap.go
+
+package fs
+
+// A wrapSet is a set of the optional interfaces that a file system
+// returned by wrapFS may implement, besides StatFS, ReadDirFS and
+// ReadFileFS, which it always implements.
+type wrapSet uint
+
+const (
+	wrapGlob      wrapSet = 1 << iota // GlobFS and RecursiveGlobFS
+	wrapSub                           // SubFS
+	wrapLstat                         // LstatFS
+	wrapReadLink                      // ReadLinkFS
+	wrapCreate                        // CreateFS
+	wrapWriteFile                     // WriteFileFS
+	wrapMkdir                         // MkdirFS
+	wrapMkdirAll                      // MkdirAllFS
+	wrapRemove                        // RemoveFS
+	wrapRemoveAll                     // RemoveAllFS
+	wrapRename                        // RenameFS
+
+	wrapLinks = wrapLstat | wrapReadLink
+	wrapWrite = wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename
+	wrapAll   = wrapGlob | wrapSub | wrapLinks | wrapWrite
+)
+
+// wrapFS returns a file system that performs its operations with the
+// methods of ops, a file system wrapping fsys. The returned file system
+// implements Open, StatFS, ReadDirFS and ReadFileFS, and each optional
+// interface in allow that ops implements and that fsys supports, so that
+// type assertions on it report what can be done with fsys. It is the
+// mechanism that the wrappers of this package use to keep the
+// optimizations of the file systems they wrap without implementing
+// interfaces that those do not.
+//
+// The top-level functions make some interfaces available on any file
+// system, or on those implementing a more primitive interface, and fsys
+// supports those in the same cases: GlobFS, RecursiveGlobFS and SubFS
+// always, WriteFileFS if fsys implements CreateFS, MkdirAllFS if it
+// implements MkdirFS, and RemoveAllFS if it implements RemoveFS.
+// The methods of ops must call the top-level functions on fsys.
+func wrapFS(ops, fsys FS, allow wrapSet) FS {
+	set := implements(ops) & supports(fsys) & allow
+	// wrapTypes holds only the sets in which those fallbacks are respected.
+	if set&wrapWriteFile == 0 {
+		set &^= wrapCreate
+	}
+	if set&wrapMkdirAll == 0 {
+		set &^= wrapMkdir
+	}
+	if set&wrapRemoveAll == 0 {
+		set &^= wrapRemove
+	}
+	return wrapTypes[set](ops)
+}
+
+// implements returns the set of the optional interfaces that fsys implements.
+func implements(fsys FS) wrapSet {
+	var set wrapSet
+	_, glob := fsys.(GlobFS)
+	_, recursiveGlob := fsys.(RecursiveGlobFS)
+	if glob && recursiveGlob {
+		set |= wrapGlob
+	}
+	if _, ok := fsys.(SubFS); ok {
+		set |= wrapSub
+	}
+	if _, ok := fsys.(LstatFS); ok {
+		set |= wrapLstat
+	}
+	if _, ok := fsys.(ReadLinkFS); ok {
+		set |= wrapReadLink
+	}
+	if _, ok := fsys.(CreateFS); ok {
+		set |= wrapCreate
+	}
+	if _, ok := fsys.(WriteFileFS); ok {
+		set |= wrapWriteFile
+	}
+	if _, ok := fsys.(MkdirFS); ok {
+		set |= wrapMkdir
+	}
+	if _, ok := fsys.(MkdirAllFS); ok {
+		set |= wrapMkdirAll
+	}
+	if _, ok := fsys.(RemoveFS); ok {
+		set |= wrapRemove
+	}
+	if _, ok := fsys.(RemoveAllFS); ok {
+		set |= wrapRemoveAll
+	}
+	if _, ok := fsys.(RenameFS); ok {
+		set |= wrapRename
+	}
+	return set
+}
+
+// supports returns the set of the optional interfaces whose top-level
+// functions can be performed on fsys, directly or through their fallbacks.
+func supports(fsys FS) wrapSet {
+	set := implements(fsys) | wrapGlob | wrapSub
+	if set&wrapCreate != 0 {
+		set |= wrapWriteFile
+	}
+	if set&wrapMkdir != 0 {
+		set |= wrapMkdirAll
+	}
+	if set&wrapRemove != 0 {
+		set |= wrapRemoveAll
+	}
+	return set
+}
+
+// The file systems returned by wrapFS are composed of the following types,
+// each implementing the methods of one element of a wrapSet by calling
+// the methods of ops, which are known to exist.
+
+type wrapBase struct{ o FS }
+type wrapGlobPart struct{ o FS }
+type wrapSubPart struct{ o FS }
+type wrapLstatPart struct{ o FS }
+type wrapReadLinkPart struct{ o FS }
+type wrapCreatePart struct{ o FS }
+type wrapWriteFilePart struct{ o FS }
+type wrapMkdirPart struct{ o FS }
+type wrapMkdirAllPart struct{ o FS }
+type wrapRemovePart struct{ o FS }
+type wrapRemoveAllPart struct{ o FS }
+type wrapRenamePart struct{ o FS }
+
+func (p wrapBase) Open(name string) (File, error) {
+	return p.o.Open(name)
+}
+
+func (p wrapBase) Stat(name string) (FileInfo, error) {
+	return Stat(p.o, name)
+}
+
+func (p wrapBase) ReadDir(name string) ([]DirEntry, error) {
+	return ReadDir(p.o, name)
+}
+
+func (p wrapBase) ReadFile(name string) ([]byte, error) {
+	return ReadFile(p.o, name)
+}
+
+func (p wrapGlobPart) Glob(pattern string) ([]string, error) {
+	return p.o.(GlobFS).Glob(pattern)
+}
+
+func (p wrapGlobPart) RecursiveGlob(pattern string) ([]string, error) {
+	return p.o.(RecursiveGlobFS).RecursiveGlob(pattern)
+}
+
+func (p wrapSubPart) Sub(dir string) (FS, error) {
+	return p.o.(SubFS).Sub(dir)
+}
+
+func (p wrapLstatPart) Lstat(name string) (FileInfo, error) {
+	return p.o.(LstatFS).Lstat(name)
+}
+
+func (p wrapReadLinkPart) ReadLink(name string) (string, error) {
+	return p.o.(ReadLinkFS).ReadLink(name)
+}
+
+func (p wrapCreatePart) Create(name string, perm FileMode) (WritableFile, error) {
+	return p.o.(CreateFS).Create(name, perm)
+}
+
+func (p wrapWriteFilePart) WriteFile(name string, data []byte, perm FileMode) error {
+	return p.o.(WriteFileFS).WriteFile(name, data, perm)
+}
+
+func (p wrapMkdirPart) Mkdir(name string, perm FileMode) error {
+	return p.o.(MkdirFS).Mkdir(name, perm)
+}
+
+func (p wrapMkdirAllPart) MkdirAll(name string, perm FileMode) error {
+	return p.o.(MkdirAllFS).MkdirAll(name, perm)
+}
+
+func (p wrapRemovePart) Remove(name string) error {
+	return p.o.(RemoveFS).Remove(name)
+}
+
+func (p wrapRemoveAllPart) RemoveAll(name string) error {
+	return p.o.(RemoveAllFS).RemoveAll(name)
+}
+
+func (p wrapRenamePart) Rename(oldname, newname string) error {
+	return p.o.(RenameFS).Rename(oldname, newname)
+}
@@ -0,0 +1,81 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import "io"
+
+// A WritableFile is a file open for writing.
+// Files returned by the Create method of a CreateFS implement WritableFile.
+type WritableFile interface {
+	File
+	Write([]byte) (int, error)
+}
+
+// CreateFS is the interface implemented by a file system
+// that can create files.
+type CreateFS interface {
+	FS
+
+	// Create creates or truncates the named file and opens it for writing.
+	// If the file does not exist, it is created with permission bits perm.
+	// If there is an error, it should be of type *PathError.
+	Create(name string, perm FileMode) (WritableFile, error)
+}
+
+// Create creates or truncates the named file in the file system fs
+// and opens it for writing.
+// If the file does not exist, it is created with permission bits perm.
+//
+// If fs implements CreateFS, Create calls fs.Create.
+// Otherwise Create returns a *PathError wrapping ErrNotImplemented.
+func Create(fsys FS, name string, perm FileMode) (WritableFile, error) {
+	if fsys, ok := fsys.(CreateFS); ok {
+		return fsys.Create(name, perm)
+	}
+	return nil, &PathError{Op: "open", Path: name, Err: ErrNotImplemented}
+}
+
+// WriteFileFS is the interface implemented by a file system
+// that provides an optimized implementation of WriteFile.
+type WriteFileFS interface {
+	FS
+
+	// WriteFile writes data to the named file, creating it if necessary.
+	// If the file does not exist, WriteFile creates it with permission bits perm;
+	// otherwise WriteFile truncates it before writing, without changing permissions.
+	WriteFile(name string, data []byte, perm FileMode) error
+}
+
+// WriteFile writes data to the named file in the file system fs,
+// creating it if necessary.
+// If the file does not exist, WriteFile creates it with permission bits perm;
+// otherwise WriteFile truncates it before writing, without changing permissions.
+//
+// If fs implements WriteFileFS, WriteFile calls fs.WriteFile.
+// Otherwise, if fs implements CreateFS, WriteFile calls fs.Create
+// and uses Write and Close on the returned file.
+// Otherwise WriteFile returns a *PathError wrapping ErrNotImplemented.
+func WriteFile(fsys FS, name string, data []byte, perm FileMode) error {
+	if fsys, ok := fsys.(WriteFileFS); ok {
+		return fsys.WriteFile(name, data, perm)
+	}
+
+	cfs, ok := fsys.(CreateFS)
+	if !ok {
+		return &PathError{Op: "write", Path: name, Err: ErrNotImplemented}
+	}
+	file, err := cfs.Create(name, perm)
+	if err != nil {
+		return err
+	}
+	n, err := file.Write(data)
+	if err == nil && n < len(data) {
+		err = &PathError{Op: "write", Path: name, Err: io.ErrShortWrite}
+	}
+	if err1 := file.Close(); err1 != nil && err == nil {
+		err = err1
+	}
+	return err
+}
@@ -0,0 +1,167 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"bytes"
+	"errors"
+	"path"
+	"testing"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+// mkdirOnly adds only the Mkdir method to a MapFS.
+type mkdirOnly struct{ openOnly }
+
+func (f mkdirOnly) Mkdir(name string, perm fs.FileMode) error {
+	if _, err := fs.Stat(f, name); err == nil {
+		return &fs.PathError{Op: "mkdir", Path: name, Err: fs.ErrExist}
+	}
+	if info, err := fs.Stat(f, path.Dir(name)); err != nil || !info.IsDir() {
+		return &fs.PathError{Op: "mkdir", Path: name, Err: fs.ErrNotExist}
+	}
+	f.FS.(fstest.MapFS)[name] = &fstest.MapFile{Mode: fs.ModeDir | perm}
+	return nil
+}
+
+// removeOnly adds only the Remove method to a MapFS.
+type removeOnly struct{ openOnly }
+
+func (f removeOnly) Remove(name string) error {
+	info, err := fs.Stat(f, name)
+	if err != nil {
+		return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrNotExist}
+	}
+	if info.IsDir() {
+		if list, _ := fs.ReadDir(f, name); len(list) > 0 {
+			return &fs.PathError{Op: "remove", Path: name, Err: errors.New("directory not empty")}
+		}
+	}
+	delete(f.FS.(fstest.MapFS), name)
+	return nil
+}
+
+// createOnly adds only the Create method to a MapFS.
+type createOnly struct{ openOnly }
+
+func (f createOnly) Create(name string, perm fs.FileMode) (fs.WritableFile, error) {
+	return &createdFile{m: f.FS.(fstest.MapFS), name: name, perm: perm}, nil
+}
+
+// A createdFile stores the data written to it in a MapFS when closed.
+type createdFile struct {
+	m    fstest.MapFS
+	name string
+	perm fs.FileMode
+	buf  bytes.Buffer
+}
+
+func (f *createdFile) Stat() (fs.FileInfo, error)  { return nil, errors.New("not supported") }
+func (f *createdFile) Read([]byte) (int, error)    { return 0, errors.New("not supported") }
+func (f *createdFile) Write(b []byte) (int, error) { return f.buf.Write(b) }
+func (f *createdFile) Close() error {
+	f.m[f.name] = &fstest.MapFile{Data: f.buf.Bytes(), Mode: f.perm}
+	return nil
+}
+
+// noReadDirFS hides the ReadDir method of the directories of an FS.
+type noReadDirFS struct{ fs.FS }
+
+func (f noReadDirFS) Open(name string) (fs.File, error) {
+	file, err := f.FS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return struct{ fs.File }{file}, nil
+}
+
+func TestNotImplemented(t *testing.T) {
+	fsys := openOnly{fstest.MapFS{"a/b": {}}}
+	_, createErr := fs.Create(fsys, "c", 0666)
+	_, readLinkErr := fs.ReadLink(fsys, "a/b")
+	_, readDirErr := fs.ReadDir(noReadDirFS{fsys}, "a")
+	walkErr := fs.WalkDirWithOptions(noReadDirFS{fsys}, ".", &fs.WalkDirOptions{Unsorted: true},
+		func(name string, d fs.DirEntry, err error) error { return err })
+	for name, err := range map[string]error{
+		"Create":    createErr,
+		"WriteFile": fs.WriteFile(fsys, "c", nil, 0666),
+		"Mkdir":     fs.Mkdir(fsys, "c", 0777),
+		"MkdirAll":  fs.MkdirAll(fsys, "c/d", 0777),
+		"Remove":    fs.Remove(fsys, "a/b"),
+		"RemoveAll": fs.RemoveAll(fsys, "a"),
+		"Rename":    fs.Rename(fsys, "a/b", "c"),
+		"ReadLink":  readLinkErr,
+		"ReadDir":   readDirErr,
+		"WalkDir":   walkErr,
+	} {
+		var pathErr *fs.PathError
+		if !errors.As(err, &pathErr) || !errors.Is(err, fs.ErrNotImplemented) {
+			t.Errorf("%s error = %v, want *PathError wrapping ErrNotImplemented", name, err)
+		}
+	}
+}
+
+func TestMkdirAllFallback(t *testing.T) {
+	m := fstest.MapFS{"a/f": {}}
+	fsys := mkdirOnly{openOnly{m}}
+	if err := fs.MkdirAll(fsys, "a/b/c", 0750); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"a/b", "a/b/c"} {
+		if f := m[name]; f == nil || f.Mode != fs.ModeDir|0750 {
+			t.Errorf("MkdirAll(a/b/c) did not create %s with mode %v", name, fs.ModeDir|0750)
+		}
+	}
+	if _, ok := m["a"]; ok {
+		t.Errorf("MkdirAll(a/b/c) called Mkdir(a), which exists")
+	}
+	if err := fs.MkdirAll(fsys, "a/b", 0750); err != nil {
+		t.Errorf("MkdirAll(a/b) of an existing directory: %v", err)
+	}
+	for _, name := range []string{"a/f", "a/f/g"} {
+		if err := fs.MkdirAll(fsys, name, 0750); !errors.Is(err, fs.ErrExist) {
+			t.Errorf("MkdirAll(%s) error = %v, want ErrExist", name, err)
+		}
+	}
+}
+
+func TestRemoveAllFallback(t *testing.T) {
+	m := fstest.MapFS{
+		"a/b/c": {},
+		"a/b/d": {},
+		"a/e":   {},
+		"a/f":   {Mode: fs.ModeDir | 0755},
+		"g":     {},
+	}
+	fsys := removeOnly{openOnly{m}}
+	if err := fs.RemoveAll(fsys, "a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := fstest.TestFS(m, "g"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fs.Stat(m, "a"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Stat(a) after RemoveAll(a) error = %v, want ErrNotExist", err)
+	}
+	if err := fs.RemoveAll(fsys, "missing"); err != nil {
+		t.Errorf("RemoveAll(missing): %v", err)
+	}
+	if err := fs.RemoveAll(fsys, "."); !errors.Is(err, fs.ErrInvalid) {
+		t.Errorf("RemoveAll(.) error = %v, want ErrInvalid", err)
+	}
+}
+
+func TestWriteFileFallback(t *testing.T) {
+	m := fstest.MapFS{}
+	fsys := createOnly{openOnly{m}}
+	if err := fs.WriteFile(fsys, "a.txt", []byte("hello"), 0640); err != nil {
+		t.Fatal(err)
+	}
+	if f := m["a.txt"]; f == nil || string(f.Data) != "hello" || f.Mode != 0640 {
+		t.Errorf("WriteFile(a.txt) stored %+v, want %q with mode %v", f, "hello", fs.FileMode(0640))
+	}
+}
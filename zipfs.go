@@ -0,0 +1,391 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+import (
+	"archive/zip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ZipFS returns a file system (an FS) for the files in the zip archive r.
+//
+// Names in the archive are used as they are, except that a trailing slash
+// marks a directory. Directories that are implied by the names of the files
+// they contain, but have no entry of their own in the archive, are
+// synthesized with mode ModeDir|0555 and a zero modification time.
+// Entries whose names do not satisfy ValidPath, such as absolute names or
+// names containing "..", are omitted; if the archive contains several
+// entries with the same name, only the first is used.
+//
+// The FileInfo of an entry is derived from its zip.FileHeader, which is
+// returned by the FileInfo's Sys method, and its FileMode from the
+// header's Mode. An entry with ModeSymlink is a symbolic link whose
+// target is its content. Open, Stat and ReadDir follow symbolic links
+// whose targets are relative names within the archive; Lstat and
+// ReadLink describe the links themselves.
+//
+// The returned file system implements StatFS, LstatFS, ReadLinkFS,
+// ReadDirFS, ReadFileFS and SubFS.
+// The archive must not be modified while the file system is in use.
+func ZipFS(r *zip.Reader) FS {
+	root := &zipEntry{name: ".", dir: true}
+	index := map[string]*zipEntry{".": root}
+	for _, f := range r.File {
+		name := strings.TrimSuffix(f.Name, "/")
+		if !ValidPath(name) || name == "." {
+			continue
+		}
+		dir := strings.HasSuffix(f.Name, "/") || f.Mode().IsDir()
+		if e := index[name]; e != nil {
+			if e.file == nil && dir {
+				// The directory was synthesized for the entries
+				// preceding its own; use its entry after all.
+				e.file = f
+			}
+			continue
+		}
+		parent := zipParent(index, path.Dir(name))
+		if parent == nil {
+			continue // a parent is a file
+		}
+		e := &zipEntry{
+			name: path.Base(name),
+			file: f,
+			dir:  dir,
+		}
+		index[name] = e
+		parent.children = append(parent.children, e)
+	}
+	for _, e := range index {
+		sort.Slice(e.children, func(i, j int) bool { return e.children[i].name < e.children[j].name })
+	}
+	return &zipFS{index, "."}
+}
+
+// zipParent returns the entry for the directory dir,
+// synthesizing it and its parents as needed.
+// It returns nil if dir or one of its parents is a file.
+func zipParent(index map[string]*zipEntry, dir string) *zipEntry {
+	if e, ok := index[dir]; ok {
+		if !e.dir {
+			return nil
+		}
+		return e
+	}
+	parent := zipParent(index, path.Dir(dir))
+	if parent == nil {
+		return nil
+	}
+	e := &zipEntry{name: path.Base(dir), dir: true}
+	index[dir] = e
+	parent.children = append(parent.children, e)
+	return e
+}
+
+// A zipEntry is a file or directory in a ZipFS.
+// It implements DirEntry and FileInfo.
+type zipEntry struct {
+	name     string    // base name
+	file     *zip.File // nil for synthesized directories
+	dir      bool
+	children []*zipEntry // directory contents, sorted by name
+}
+
+func (e *zipEntry) Name() string { return e.name }
+func (e *zipEntry) IsDir() bool  { return e.dir }
+
+func (e *zipEntry) Size() int64 {
+	if e.file == nil || e.dir {
+		return 0
+	}
+	return int64(e.file.UncompressedSize64)
+}
+
+func (e *zipEntry) Mode() FileMode {
+	if e.file == nil {
+		return ModeDir | 0555
+	}
+	mode := FileMode(e.file.Mode())
+	if e.dir {
+		mode |= ModeDir
+	}
+	return mode
+}
+
+func (e *zipEntry) Type() FileMode { return e.Mode().Type() }
+
+func (e *zipEntry) ModTime() time.Time {
+	if e.file == nil {
+		return time.Time{}
+	}
+	return e.file.FileInfo().ModTime()
+}
+
+func (e *zipEntry) Sys() interface{} {
+	if e.file == nil {
+		return nil
+	}
+	return &e.file.FileHeader
+}
+
+func (e *zipEntry) Info() (FileInfo, error) { return e, nil }
+
+func (e *zipEntry) isLink() bool {
+	return e.file != nil && !e.dir && FileMode(e.file.Mode())&ModeSymlink != 0
+}
+
+// readLink returns the target of the symbolic link e.
+func (e *zipEntry) readLink() (string, error) {
+	rc, err := e.file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+	target, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return "", err
+	}
+	return string(target), nil
+}
+
+// named returns the FileInfo of e for the file named name, which leads
+// to e through symbolic links, as tarEntry.named does.
+func (e *zipEntry) named(name string) FileInfo {
+	if base := path.Base(name); name != "." && base != e.name {
+		return &namedFileInfo{e, base}
+	}
+	return e
+}
+
+// A zipFS is the file system returned by ZipFS, or a subtree of it.
+type zipFS struct {
+	index map[string]*zipEntry
+	dir   string // root of the subtree
+}
+
+// lookup returns the entry for name, following symbolic links
+// in its directory elements, and in its final element if follow is true.
+func (z *zipFS) lookup(op, name string, follow bool) (*zipEntry, error) {
+	if !ValidPath(name) {
+		return nil, &PathError{Op: op, Path: name, Err: ErrInvalid}
+	}
+	full := name
+	if z.dir != "." {
+		full = path.Join(z.dir, name)
+	}
+	e, err := z.resolve(full, follow)
+	if err != nil {
+		return nil, &PathError{Op: op, Path: name, Err: err}
+	}
+	return e, nil
+}
+
+// resolve returns the entry named full, as described for zipFS.lookup.
+func (z *zipFS) resolve(full string, follow bool) (*zipEntry, error) {
+	links := 0
+	cur := "."
+	rest := full
+	for rest != "" {
+		elem := rest
+		if i := strings.IndexByte(rest, '/'); i >= 0 {
+			elem, rest = rest[:i], rest[i+1:]
+		} else {
+			rest = ""
+		}
+		switch elem {
+		case "", ".":
+			continue
+		case "..":
+			if cur == "." {
+				return nil, ErrNotExist
+			}
+			cur = path.Dir(cur)
+			continue
+		}
+
+		next := path.Join(cur, elem)
+		e := z.index[next]
+		if e == nil {
+			return nil, ErrNotExist
+		}
+		if e.isLink() && (rest != "" || follow) {
+			if links++; links > 255 {
+				return nil, ErrSymlinkLoop
+			}
+			target, err := e.readLink()
+			if err != nil {
+				return nil, err
+			}
+			if target == "" || path.IsAbs(target) {
+				return nil, ErrNotExist
+			}
+			if rest != "" {
+				target += "/" + rest
+			}
+			rest = target
+			continue
+		}
+		if rest != "" && !e.dir {
+			return nil, ErrNotExist
+		}
+		cur = next
+	}
+	return z.index[cur], nil
+}
+
+func (z *zipFS) Open(name string) (File, error) {
+	e, err := z.lookup("open", name, true)
+	if err != nil {
+		return nil, err
+	}
+	if e.dir {
+		return &zipDir{e: e, path: name}, nil
+	}
+	rc, err := e.file.Open()
+	if err != nil {
+		return nil, &PathError{Op: "open", Path: name, Err: err}
+	}
+	return &zipFile{e: e, path: name, rc: rc}, nil
+}
+
+func (z *zipFS) Stat(name string) (FileInfo, error) {
+	e, err := z.lookup("stat", name, true)
+	if err != nil {
+		return nil, err
+	}
+	return e.named(name), nil
+}
+
+func (z *zipFS) Lstat(name string) (FileInfo, error) {
+	e, err := z.lookup("lstat", name, false)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (z *zipFS) ReadLink(name string) (string, error) {
+	e, err := z.lookup("readlink", name, false)
+	if err != nil {
+		return "", err
+	}
+	if !e.isLink() {
+		return "", &PathError{Op: "readlink", Path: name, Err: ErrInvalid}
+	}
+	target, err := e.readLink()
+	if err != nil {
+		return "", &PathError{Op: "readlink", Path: name, Err: err}
+	}
+	return target, nil
+}
+
+func (z *zipFS) ReadDir(name string) ([]DirEntry, error) {
+	e, err := z.lookup("readdir", name, true)
+	if err != nil {
+		return nil, err
+	}
+	if !e.dir {
+		return nil, &PathError{Op: "readdir", Path: name, Err: errors.New("not a directory")}
+	}
+	list := make([]DirEntry, len(e.children))
+	for i, c := range e.children {
+		list[i] = c
+	}
+	return list, nil
+}
+
+func (z *zipFS) ReadFile(name string) ([]byte, error) {
+	e, err := z.lookup("read", name, true)
+	if err != nil {
+		return nil, err
+	}
+	if e.dir {
+		return nil, &PathError{Op: "read", Path: name, Err: errors.New("is a directory")}
+	}
+	rc, err := e.file.Open()
+	if err != nil {
+		return nil, &PathError{Op: "read", Path: name, Err: err}
+	}
+	defer rc.Close()
+	data, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, &PathError{Op: "read", Path: name, Err: err}
+	}
+	return data, nil
+}
+
+func (z *zipFS) Sub(dir string) (FS, error) {
+	if !ValidPath(dir) {
+		return nil, &PathError{Op: "sub", Path: dir, Err: errors.New("invalid name")}
+	}
+	if dir == "." {
+		return z, nil
+	}
+	return &zipFS{z.index, path.Join(z.dir, dir)}, nil
+}
+
+// A zipFile is a regular file opened by a zipFS.
+type zipFile struct {
+	e    *zipEntry
+	path string
+	rc   io.ReadCloser // nil once closed
+}
+
+func (f *zipFile) Stat() (FileInfo, error) { return f.e.named(f.path), nil }
+
+func (f *zipFile) Read(b []byte) (int, error) {
+	if f.rc == nil {
+		return 0, &PathError{Op: "read", Path: f.path, Err: ErrClosed}
+	}
+	n, err := f.rc.Read(b)
+	if err != nil && err != io.EOF {
+		err = &PathError{Op: "read", Path: f.path, Err: err}
+	}
+	return n, err
+}
+
+func (f *zipFile) Close() error {
+	if f.rc == nil {
+		return &PathError{Op: "close", Path: f.path, Err: ErrClosed}
+	}
+	err := f.rc.Close()
+	f.rc = nil
+	return err
+}
+
+// A zipDir is a directory opened by a zipFS.
+type zipDir struct {
+	e      *zipEntry
+	path   string
+	offset int
+}
+
+func (d *zipDir) Stat() (FileInfo, error) { return d.e.named(d.path), nil }
+func (d *zipDir) Close() error            { return nil }
+func (d *zipDir) Read(b []byte) (int, error) {
+	return 0, &PathError{Op: "read", Path: d.path, Err: errors.New("is a directory")}
+}
+
+func (d *zipDir) ReadDir(count int) ([]DirEntry, error) {
+	n := len(d.e.children) - d.offset
+	if n == 0 && count > 0 {
+		return nil, io.EOF
+	}
+	if count > 0 && n > count {
+		n = count
+	}
+	list := make([]DirEntry, n)
+	for i := range list {
+		list[i] = d.e.children[d.offset+i]
+	}
+	d.offset += n
+	return list, nil
+}
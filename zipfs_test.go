@@ -0,0 +1,161 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/vedranvuk/fs"
+	"github.com/vedranvuk/fs/fstest"
+)
+
+// zipEntry describes an entry to write to a test zip archive.
+type zipEntry struct {
+	name string
+	mode os.FileMode
+	body string
+}
+
+func makeZip(t *testing.T, mtime time.Time, entries ...zipEntry) *zip.Reader {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, e := range entries {
+		hdr := &zip.FileHeader{Name: e.name, Method: zip.Deflate, Modified: mtime}
+		hdr.SetMode(e.mode)
+		w, err := zw.CreateHeader(hdr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(e.body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return zr
+}
+
+func TestZipFS(t *testing.T) {
+	mtime := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	zr := makeZip(t, mtime,
+		zipEntry{"hello.txt", 0644, "hello, world\n"},
+		zipEntry{"dir/", os.ModeDir | 0755, ""},
+		zipEntry{"dir/a.go", 0644, "package a\n"},
+		zipEntry{"implied/deep/b.go", 0600, "package b\n"},
+		zipEntry{"hello.txt", 0644, "duplicate"},
+		zipEntry{"../escape.txt", 0644, "escape"},
+		zipEntry{"/abs.txt", 0644, "abs"},
+	)
+	fsys := fs.ZipFS(zr)
+	if err := fstest.TestFS(fsys, "hello.txt", "dir", "dir/a.go", "implied/deep/b.go"); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := fs.ReadFile(fsys, "hello.txt"); string(data) != "hello, world\n" || err != nil {
+		t.Errorf("ReadFile(hello.txt) = %q, %v, want the first entry", data, err)
+	}
+	if list, err := fs.ReadDir(fsys, "."); len(list) != 3 || err != nil {
+		t.Errorf("ReadDir(.) = %d entries, %v, want 3 entries", len(list), err)
+	}
+	info, err := fs.Stat(fsys, "implied")
+	if err != nil || info.Mode() != fs.ModeDir|0555 || !info.ModTime().IsZero() {
+		t.Errorf("Stat(implied) = %v, %v, want a synthesized directory", info, err)
+	}
+}
+
+func TestZipFSDirAfterChildren(t *testing.T) {
+	mtime := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	zr := makeZip(t, mtime,
+		zipEntry{"a/f", 0644, "f"},
+		zipEntry{"a/", os.ModeDir | 0750, ""},
+	)
+	fsys := fs.ZipFS(zr)
+	if err := fstest.TestFS(fsys, "a", "a/f"); err != nil {
+		t.Fatal(err)
+	}
+	info, err := fs.Stat(fsys, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode() != fs.ModeDir|0750 || !info.ModTime().Equal(mtime) || info.Sys() == nil {
+		t.Errorf("Stat(a) = %v %v %v, want the explicit entry with mode %v and time %v",
+			info.Mode(), info.ModTime(), info.Sys(), fs.ModeDir|0750, mtime)
+	}
+}
+
+func TestZipFSSymlinks(t *testing.T) {
+	mtime := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	zr := makeZip(t, mtime,
+		zipEntry{"dir/a.txt", 0644, "a"},
+		zipEntry{"dir/up.link", os.ModeSymlink | 0777, "../b.txt"},
+		zipEntry{"b.txt", 0644, "b"},
+		zipEntry{"dir.link", os.ModeSymlink | 0777, "dir"},
+		zipEntry{"dangling.link", os.ModeSymlink | 0777, "missing"},
+	)
+	fsys := fs.ZipFS(zr)
+	if err := fstest.TestFS(fsys, "dir/a.txt", "dir/up.link", "b.txt", "dir.link", "dangling.link"); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, want := range map[string]string{
+		"dir/up.link":      "b",
+		"dir.link/a.txt":   "a",
+		"dir.link/up.link": "b",
+	} {
+		if data, err := fs.ReadFile(fsys, name); string(data) != want || err != nil {
+			t.Errorf("ReadFile(%s) = %q, %v, want %q, nil", name, data, err, want)
+		}
+	}
+	if target, err := fs.ReadLink(fsys, "dir.link"); target != "dir" || err != nil {
+		t.Errorf("ReadLink(dir.link) = %q, %v, want %q, nil", target, err, "dir")
+	}
+	if _, err := fs.ReadLink(fsys, "b.txt"); err == nil {
+		t.Errorf("ReadLink(b.txt) succeeded, want error")
+	}
+	if info, err := fs.Lstat(fsys, "dir.link"); err != nil || info.Mode()&fs.ModeSymlink == 0 {
+		t.Errorf("Lstat(dir.link) = %v, %v, want a symbolic link", info, err)
+	}
+	if _, err := fs.Stat(fsys, "dangling.link"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Stat(dangling.link) error = %v, want ErrNotExist", err)
+	}
+	loop := fs.ZipFS(makeZip(t, mtime, zipEntry{"loop.link", os.ModeSymlink | 0777, "loop.link"}))
+	if _, err := fs.Stat(loop, "loop.link"); !errors.Is(err, fs.ErrSymlinkLoop) {
+		t.Errorf("Stat(loop.link) error = %v, want ErrSymlinkLoop", err)
+	}
+
+	// Following a link keeps its name, as os.Stat does.
+	for _, name := range []string{"dir/up.link", "dir.link", "dir.link/up.link"} {
+		info, err := fs.Stat(fsys, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := fsys.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finfo, err := f.Stat()
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if base := path.Base(name); info.Name() != base || finfo.Name() != base {
+			t.Errorf("Stat(%s).Name() = %q, file.Stat().Name() = %q, want %q", name, info.Name(), finfo.Name(), base)
+		}
+		if info.Mode()&fs.ModeSymlink != 0 {
+			t.Errorf("Stat(%s) reports a symbolic link", name)
+		}
+	}
+}
@@ -0,0 +1,9084 @@
+// Code generated by mkwrap.go; DO NOT EDIT.
+
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fs
+
+// wrapTypes maps each wrapSet that wrapFS can use to a function
+// returning a file system composed of the parts implementing it.
+var wrapTypes = map[wrapSet]func(o FS) FS{
+	0: func(o FS) FS {
+		return &struct {
+			wrapBase
+		}{wrapBase{o}}
+	},
+	wrapGlob: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+		}{wrapBase{o}, wrapGlobPart{o}}
+	},
+	wrapSub: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+		}{wrapBase{o}, wrapSubPart{o}}
+	},
+	wrapGlob | wrapSub: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}}
+	},
+	wrapLstat: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+		}{wrapBase{o}, wrapLstatPart{o}}
+	},
+	wrapGlob | wrapLstat: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}}
+	},
+	wrapSub | wrapLstat: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}}
+	},
+	wrapReadLink: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+		}{wrapBase{o}, wrapReadLinkPart{o}}
+	},
+	wrapGlob | wrapReadLink: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}}
+	},
+	wrapSub | wrapReadLink: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}}
+	},
+	wrapLstat | wrapReadLink: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}}
+	},
+	wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}}
+	},
+	wrapSub | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}}
+	},
+	wrapLstat | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}}
+	},
+	wrapReadLink | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}}
+	},
+	wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}}
+	},
+	wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapLstat | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapReadLink | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapLstat | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapReadLink | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}}
+	},
+	wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}}
+	},
+	wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapRenamePart
+		}{wrapBase{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRenamePart{o}}
+	},
+	wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRenamePart{o}}
+	},
+	wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+	wrapGlob | wrapSub | wrapLstat | wrapReadLink | wrapCreate | wrapWriteFile | wrapMkdir | wrapMkdirAll | wrapRemove | wrapRemoveAll | wrapRename: func(o FS) FS {
+		return &struct {
+			wrapBase
+			wrapGlobPart
+			wrapSubPart
+			wrapLstatPart
+			wrapReadLinkPart
+			wrapCreatePart
+			wrapWriteFilePart
+			wrapMkdirPart
+			wrapMkdirAllPart
+			wrapRemovePart
+			wrapRemoveAllPart
+			wrapRenamePart
+		}{wrapBase{o}, wrapGlobPart{o}, wrapSubPart{o}, wrapLstatPart{o}, wrapReadLinkPart{o}, wrapCreatePart{o}, wrapWriteFilePart{o}, wrapMkdirPart{o}, wrapMkdirAllPart{o}, wrapRemovePart{o}, wrapRemoveAllPart{o}, wrapRenamePart{o}}
+	},
+}